@@ -0,0 +1,8 @@
+package ack
+
+// Config for event acknowledgement and redelivery
+type Config struct {
+	Enabled      bool
+	TimeoutInSec int
+	MaxAttempts  int
+}
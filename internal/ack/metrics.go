@@ -0,0 +1,28 @@
+package ack
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	eventsAcked = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_events_acked_total",
+		Help: "Total number of events acknowledged by clients",
+	})
+
+	eventsRedelivered = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_events_redelivered_total",
+		Help: "Total number of events redelivered to clients after ack timeout",
+	})
+
+	eventsUnacked = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_events_unacked_total",
+		Help: "Total number of events dropped after exhausting delivery attempts",
+	})
+
+	eventsExpired = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_events_expired_before_ack_total",
+		Help: "Total number of un-acked events dropped from redelivery as they expired",
+	})
+)
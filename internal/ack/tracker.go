@@ -0,0 +1,115 @@
+package ack
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+)
+
+const (
+	// CheckInterval is the interval at which pending events are checked for redelivery
+	CheckInterval = 1 * time.Second
+
+	defaultTimeout     = 10 * time.Second
+	defaultMaxAttempts = 3
+)
+
+// Tracker keeps track of events sent on a channel which are yet to be acknowledged by the client.
+// A tracker is owned by a single channel.
+type Tracker struct {
+	mu          sync.Mutex
+	timeout     time.Duration
+	maxAttempts int
+	seq         uint64
+	pending     map[string]*pendingEvent
+}
+
+type pendingEvent struct {
+	event    *pushv1.ChannelEvent
+	attempts int
+	sentAt   time.Time
+	seq      uint64
+}
+
+// NewTracker returns a new tracker
+func NewTracker(config Config) *Tracker {
+	timeout := time.Duration(config.TimeoutInSec) * time.Second
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	maxAttempts := config.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	return &Tracker{
+		timeout:     timeout,
+		maxAttempts: maxAttempts,
+		pending:     make(map[string]*pendingEvent),
+	}
+}
+
+// Track records the first delivery attempt of an event
+func (t *Tracker) Track(event *pushv1.ChannelEvent, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.seq++
+	t.pending[event.GetUniqueId()] = &pendingEvent{event: event, attempts: 1, sentAt: now, seq: t.seq}
+}
+
+// Ack marks the event as acknowledged, returns false if the event is not pending
+func (t *Tracker) Ack(uniqueID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.pending[uniqueID]
+	if !ok {
+		return false
+	}
+	delete(t.pending, uniqueID)
+	eventsAcked.Inc()
+	return true
+}
+
+// Due returns the events whose ack has timed out, in the order they were first sent.
+// Returned events are counted as a new delivery attempt, events which have exhausted
+// their attempts or have expired are dropped.
+func (t *Tracker) Due(now time.Time) []*pushv1.ChannelEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var due []*pendingEvent
+	for id, p := range t.pending {
+		if now.Sub(p.sentAt) < t.timeout {
+			continue
+		}
+		if expiresAt := p.event.GetEvent().GetExpiresAt(); expiresAt != nil && !now.Before(expiresAt.AsTime()) {
+			delete(t.pending, id)
+			eventsExpired.Inc()
+			continue
+		}
+		if p.attempts >= t.maxAttempts {
+			delete(t.pending, id)
+			eventsUnacked.Inc()
+			continue
+		}
+		p.attempts++
+		p.sentAt = now
+		due = append(due, p)
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].seq < due[j].seq
+	})
+	events := make([]*pushv1.ChannelEvent, 0, len(due))
+	for _, p := range due {
+		events = append(events, p.event)
+		eventsRedelivered.Inc()
+	}
+	return events
+}
+
+// Len returns the number of events pending ack
+func (t *Tracker) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.pending)
+}
@@ -0,0 +1,67 @@
+package ack
+
+import (
+	"testing"
+	"time"
+
+	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTracker_Ack(t *testing.T) {
+	tracker := NewTracker(Config{Enabled: true, TimeoutInSec: 1, MaxAttempts: 2})
+	now := time.Now()
+
+	tracker.Track(&pushv1.ChannelEvent{UniqueId: "1"}, now)
+	assert.Equal(t, 1, tracker.Len())
+
+	assert.True(t, tracker.Ack("1"))
+	assert.False(t, tracker.Ack("1"))
+	assert.False(t, tracker.Ack("unknown"))
+	assert.Equal(t, 0, tracker.Len())
+	assert.Empty(t, tracker.Due(now.Add(time.Minute)))
+}
+
+func TestTracker_Due(t *testing.T) {
+	tracker := NewTracker(Config{Enabled: true, TimeoutInSec: 1, MaxAttempts: 2})
+	now := time.Now()
+
+	tracker.Track(&pushv1.ChannelEvent{UniqueId: "1"}, now)
+	tracker.Track(&pushv1.ChannelEvent{UniqueId: "2"}, now.Add(10*time.Millisecond))
+	tracker.Track(&pushv1.ChannelEvent{UniqueId: "3"}, now.Add(2*time.Second))
+
+	// nothing is due before the timeout
+	assert.Empty(t, tracker.Due(now.Add(500*time.Millisecond)))
+
+	// first two are redelivered in order
+	due := tracker.Due(now.Add(1100 * time.Millisecond))
+	assert.Len(t, due, 2)
+	assert.Equal(t, "1", due[0].GetUniqueId())
+	assert.Equal(t, "2", due[1].GetUniqueId())
+
+	// attempts are exhausted for the first two, third one is redelivered
+	due = tracker.Due(now.Add(3 * time.Second))
+	assert.Len(t, due, 1)
+	assert.Equal(t, "3", due[0].GetUniqueId())
+	assert.Equal(t, 1, tracker.Len())
+}
+
+func TestTracker_DueDropsExpired(t *testing.T) {
+	tracker := NewTracker(Config{Enabled: true, TimeoutInSec: 1, MaxAttempts: 3})
+	now := time.Now()
+
+	tracker.Track(&pushv1.ChannelEvent{UniqueId: "1", Event: &pushv1.Event{ExpiresAt: timestamppb.New(now.Add(time.Second))}}, now)
+	tracker.Track(&pushv1.ChannelEvent{UniqueId: "2", Event: &pushv1.Event{}}, now)
+
+	due := tracker.Due(now.Add(2 * time.Second))
+	assert.Len(t, due, 1)
+	assert.Equal(t, "2", due[0].GetUniqueId())
+	assert.Equal(t, 1, tracker.Len())
+}
+
+func TestNewTracker_Defaults(t *testing.T) {
+	tracker := NewTracker(Config{Enabled: true})
+	assert.Equal(t, defaultTimeout, tracker.timeout)
+	assert.Equal(t, defaultMaxAttempts, tracker.maxAttempts)
+}
@@ -0,0 +1,129 @@
+package authz
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/pkg/broker"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+)
+
+const (
+	clientIDPlaceholder = "{clientID}"
+	deviceIDPlaceholder = "{deviceID}"
+)
+
+// IAuthorizer authorizes topic subscriptions of clients
+type IAuthorizer interface {
+	// Authorize returns a PermissionDenied error if the subscription is not allowed
+	Authorize(ctx context.Context, request Request) error
+}
+
+// Request of a client to subscribe to a topic
+type Request struct {
+	ClientID string `json:"client_id"`
+	DeviceID string `json:"device_id"`
+	Topic    string `json:"topic"`
+}
+
+// New returns an authorizer for the config, every subscription is allowed if it is not enabled
+func New(config Config) (IAuthorizer, error) {
+	if !config.Enabled {
+		return allowAll{}, nil
+	}
+	rules, err := newRuleAuthorizer(config.AllowPatterns, config.DenyPatterns)
+	if err != nil {
+		return nil, err
+	}
+	if config.HTTPEndpoint == "" {
+		return rules, nil
+	}
+	return chain{rules, newHTTPAuthorizer(config)}, nil
+}
+
+type allowAll struct{}
+
+// Authorize allows every subscription
+func (allowAll) Authorize(ctx context.Context, request Request) error {
+	return nil
+}
+
+// chain of authorizers, a subscription is allowed only if every authorizer allows it
+type chain []IAuthorizer
+
+// Authorize consults the authorizers in order
+func (c chain) Authorize(ctx context.Context, request Request) error {
+	for _, a := range c {
+		err := a.Authorize(ctx, request)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ruleAuthorizer authorizes with the patterns of the config, deny patterns take precedence
+type ruleAuthorizer struct {
+	allowPatterns []string
+	denyPatterns  []string
+}
+
+func newRuleAuthorizer(allowPatterns []string, denyPatterns []string) (*ruleAuthorizer, error) {
+	for _, p := range append(append([]string{}, allowPatterns...), denyPatterns...) {
+		_, err := path.Match(p, "")
+		if err != nil {
+			return nil, perror.Newf(perror.InvalidArgument, "invalid authz pattern %s: %v", p, err)
+		}
+	}
+	return &ruleAuthorizer{allowPatterns: allowPatterns, denyPatterns: denyPatterns}, nil
+}
+
+// Authorize checks the topic against the deny patterns and then the allow patterns
+func (r *ruleAuthorizer) Authorize(ctx context.Context, request Request) error {
+	for _, p := range r.denyPatterns {
+		if matches(p, request) || mayOverlap(p, request) {
+			return deny(ctx, "rules", request)
+		}
+	}
+	if len(r.allowPatterns) == 0 {
+		return nil
+	}
+	for _, p := range r.allowPatterns {
+		if matches(p, request) {
+			return nil
+		}
+	}
+	return deny(ctx, "rules", request)
+}
+
+// matches checks if the topic matches the pattern with the placeholders filled in for the client
+func matches(pattern string, request Request) bool {
+	pattern = strings.ReplaceAll(pattern, clientIDPlaceholder, broker.EscapeGlob(request.ClientID))
+	pattern = strings.ReplaceAll(pattern, deviceIDPlaceholder, broker.EscapeGlob(request.DeviceID))
+	ok, err := path.Match(pattern, request.Topic)
+	return err == nil && ok
+}
+
+// mayOverlap checks if a wildcard topic may cover a topic matching the pattern, by their literal prefixes
+func mayOverlap(pattern string, request Request) bool {
+	if !broker.IsWildcard(request.Topic) {
+		return false
+	}
+	pattern = strings.ReplaceAll(pattern, clientIDPlaceholder, broker.EscapeGlob(request.ClientID))
+	pattern = strings.ReplaceAll(pattern, deviceIDPlaceholder, broker.EscapeGlob(request.DeviceID))
+	patternPrefix := pattern
+	if i := strings.IndexAny(pattern, `*?[\`); i >= 0 {
+		patternPrefix = pattern[:i]
+	}
+	topicPrefix := broker.LiteralPrefix(request.Topic)
+	return strings.HasPrefix(patternPrefix, topicPrefix) || strings.HasPrefix(topicPrefix, patternPrefix)
+}
+
+func deny(ctx context.Context, authorizer string, request Request) error {
+	subscriptionsDenied.WithLabelValues(authorizer).Inc()
+	pErr := perror.Newf(perror.PermissionDenied, "client %s is not allowed to subscribe to topic %s", request.ClientID, request.Topic)
+	logger.Ctx(ctx).Error(pErr.Error())
+	return pErr
+}
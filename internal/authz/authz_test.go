@@ -0,0 +1,90 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertDenied(t *testing.T, err error) {
+	pErr, ok := err.(*perror.PError)
+	if assert.True(t, ok) {
+		assert.Equal(t, perror.PermissionDenied, pErr.Code())
+	}
+}
+
+func TestNew(t *testing.T) {
+	a, err := New(Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, a.Authorize(context.Background(), Request{ClientID: "client1", Topic: "client2"}))
+
+	_, err = New(Config{Enabled: true, AllowPatterns: []string{"user.[.*"}})
+	assert.Error(t, err)
+}
+
+func TestRuleAuthorizer_Authorize(t *testing.T) {
+	_, err := logger.NewLogger("dev", nil, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	a, err := New(Config{
+		Enabled:       true,
+		AllowPatterns: []string{"user.{clientID}.*", "orders.*"},
+		DenyPatterns:  []string{"orders.internal*"},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "user.client1.profile"}))
+	assert.NoError(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "orders.created"}))
+	assertDenied(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "user.client2.profile"}))
+	assertDenied(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "client2"}))
+	assertDenied(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "orders.internal.audit"}))
+
+	t.Run("ids match only themselves", func(t *testing.T) {
+		assertDenied(t, a.Authorize(ctx, Request{ClientID: "*", Topic: "user.client2.profile"}))
+	})
+
+	t.Run("wildcard covering a denied topic", func(t *testing.T) {
+		assertDenied(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "orders.>"}))
+		assert.NoError(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "user.client1.>"}))
+	})
+}
+
+func TestHTTPAuthorizer_Authorize(t *testing.T) {
+	_, err := logger.NewLogger("dev", nil, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		switch req.Topic {
+		case "allowed":
+			w.WriteHeader(http.StatusOK)
+		case "denied":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	a, err := New(Config{Enabled: true, DenyPatterns: []string{"blocked"}, HTTPEndpoint: server.URL})
+	assert.NoError(t, err)
+
+	assert.NoError(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "allowed"}))
+	assertDenied(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "denied"}))
+	assertDenied(t, a.Authorize(ctx, Request{ClientID: "client1", Topic: "blocked"}))
+
+	err = a.Authorize(ctx, Request{ClientID: "client1", Topic: "unknown"})
+	pErr, ok := err.(*perror.PError)
+	if assert.True(t, ok) {
+		assert.Equal(t, perror.Unavailable, pErr.Code())
+	}
+}
@@ -0,0 +1,12 @@
+package authz
+
+// Config for authorizing topic subscriptions of clients
+type Config struct {
+	Enabled bool
+	// AllowPatterns a topic must match, if any, e.g. user.{clientID}.*
+	AllowPatterns []string
+	// DenyPatterns a topic must not match
+	DenyPatterns    []string
+	HTTPEndpoint    string
+	HTTPTimeoutInMs int
+}
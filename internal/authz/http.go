@@ -0,0 +1,68 @@
+package authz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+)
+
+const defaultHTTPTimeout = 1 * time.Second
+
+// httpAuthorizer asks an external HTTP endpoint, a 2xx response allows the subscription
+// and a 401 or 403 denies it
+type httpAuthorizer struct {
+	httpClient *http.Client
+	endpoint   string
+}
+
+func newHTTPAuthorizer(config Config) *httpAuthorizer {
+	h := &httpAuthorizer{
+		httpClient: &http.Client{Timeout: defaultHTTPTimeout},
+		endpoint:   config.HTTPEndpoint,
+	}
+	if config.HTTPTimeoutInMs > 0 {
+		h.httpClient.Timeout = time.Duration(config.HTTPTimeoutInMs) * time.Millisecond
+	}
+	return h
+}
+
+// Authorize posts the request as JSON to the endpoint
+func (h *httpAuthorizer) Authorize(ctx context.Context, request Request) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to marshal authz request %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.endpoint, bytes.NewReader(body))
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to create authz request %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		pErr := perror.Newf(perror.Unavailable, "error in calling authorizer %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	defer resp.Body.Close()
+	// drain so that the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return deny(ctx, "http", request)
+	}
+	pErr := perror.Newf(perror.Unavailable, "authorizer returned status %d", resp.StatusCode)
+	logger.Ctx(ctx).Error(pErr.Error())
+	return pErr
+}
@@ -0,0 +1,13 @@
+package authz
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	subscriptionsDenied = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "propeller_authz_subscriptions_denied_total",
+		Help: "Total number of topic subscriptions denied",
+	}, []string{"authorizer"})
+)
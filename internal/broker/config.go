@@ -9,6 +9,8 @@ import (
 type Config struct {
 	Broker      string
 	Persistence bool
-	Nats        natspkg.Config
-	Redis       redispkg.Config
+	// RetentionInSec limits the age of events retained when persistence is enabled, 0 means no limit
+	RetentionInSec int
+	Nats           natspkg.Config
+	Redis          redispkg.Config
 }
@@ -0,0 +1,13 @@
+package cluster
+
+// Config for registering the node with the cluster
+type Config struct {
+	Enabled bool
+	// NodeID of the node, a random id if not set
+	NodeID string
+	// Address at which the node is reachable, the grpc address if not set
+	Address                string
+	HeartbeatIntervalInSec int
+	// NodeTTLInSec after which a node without heartbeat is no longer listed
+	NodeTTLInSec int
+}
@@ -0,0 +1,13 @@
+package cluster
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	heartbeatFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_node_heartbeat_failures_total",
+		Help: "Total number of heartbeats of the node which could not be stored",
+	})
+)
@@ -0,0 +1,162 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/kv"
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// nodesKey holds the nodes of the cluster, with node id as field and node as value
+	nodesKey = "propeller#nodes"
+
+	defaultHeartbeatInterval = 10 * time.Second
+	defaultNodeTTL           = 30 * time.Second
+)
+
+// Node is a propeller instance of the cluster
+type Node struct {
+	ID          string    `json:"id"`
+	Address     string    `json:"address"`
+	StartedAt   time.Time `json:"started_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+	Connections int       `json:"connections"`
+}
+
+// ToProto converts to proto
+func (n Node) ToProto() *pushv1.Node {
+	return &pushv1.Node{
+		Id:          n.ID,
+		Address:     n.Address,
+		StartedAt:   timestamppb.New(n.StartedAt),
+		LastSeenAt:  timestamppb.New(n.LastSeenAt),
+		Connections: int64(n.Connections),
+	}
+}
+
+// Registry registers this node with the cluster in the kv store with a heartbeat,
+// and lists the live nodes of the cluster
+type Registry struct {
+	kv                kv.IKV
+	enabled           bool
+	node              Node
+	heartbeatInterval time.Duration
+	ttl               time.Duration
+}
+
+// NewRegistry returns a registry of the node, address is used if the config has no address
+func NewRegistry(kv kv.IKV, config Config, address string) *Registry {
+	nodeID := config.NodeID
+	if nodeID == "" {
+		nodeID = uuid.NewString()
+	}
+	if config.Address != "" {
+		address = config.Address
+	}
+	heartbeatInterval := time.Duration(config.HeartbeatIntervalInSec) * time.Second
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = defaultHeartbeatInterval
+	}
+	ttl := time.Duration(config.NodeTTLInSec) * time.Second
+	if ttl <= 0 {
+		ttl = defaultNodeTTL
+	}
+	return &Registry{
+		kv:                kv,
+		enabled:           config.Enabled,
+		node:              Node{ID: nodeID, Address: address, StartedAt: time.Now()},
+		heartbeatInterval: heartbeatInterval,
+		ttl:               ttl,
+	}
+}
+
+// NodeID returns the id of this node
+func (r *Registry) NodeID() string {
+	return r.node.ID
+}
+
+// Enabled checks if the node is registered with the cluster
+func (r *Registry) Enabled() bool {
+	return r.enabled
+}
+
+// Run sends heartbeats of the node with the number of connections till ctx is done, and then deregisters it
+func (r *Registry) Run(ctx context.Context, connections func() int) error {
+	if !r.enabled {
+		return nil
+	}
+	logger.Ctx(ctx).Infow("registering node", "nodeID", r.node.ID, "address", r.node.Address)
+	ticker := time.NewTicker(r.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		r.heartbeat(ctx, connections())
+		select {
+		case <-ctx.Done():
+			err := r.kv.Delete(context.WithoutCancel(ctx), nodesKey, r.node.ID)
+			if err != nil {
+				logger.Ctx(ctx).Errorw("error in deregistering node", "error", err.Error())
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Registry) heartbeat(ctx context.Context, connections int) {
+	node := r.node
+	node.LastSeenAt = time.Now()
+	node.Connections = connections
+	v, err := json.Marshal(node)
+	if err != nil {
+		heartbeatFailures.Inc()
+		logger.Ctx(ctx).Errorw("error in marshalling node", "error", err.Error())
+		return
+	}
+	err = r.kv.Store(ctx, nodesKey, node.ID, string(v))
+	if err != nil {
+		heartbeatFailures.Inc()
+		logger.Ctx(ctx).Errorw("error in storing node heartbeat", "error", err.Error())
+	}
+}
+
+// ListNodes returns the live nodes of the cluster sorted by id. Nodes which missed their
+// heartbeats for the ttl, e.g. a crashed node, are stale and deleted.
+func (r *Registry) ListNodes(ctx context.Context) ([]Node, error) {
+	if !r.enabled {
+		return nil, perror.New(perror.FailedPrecondition, "cluster registry disabled")
+	}
+	v, err := r.kv.Load(ctx, nodesKey)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	nodes := []Node{}
+	var staleIDs []string
+	for id, value := range v {
+		var node Node
+		err := json.Unmarshal([]byte(value), &node)
+		if err != nil || now.Sub(node.LastSeenAt) > r.ttl {
+			staleIDs = append(staleIDs, id)
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	if len(staleIDs) > 0 {
+		err = r.kv.Delete(ctx, nodesKey, staleIDs...)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error in deleting stale nodes", "error", err.Error())
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+	return nodes, nil
+}
@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/kv"
+	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKV(t *testing.T) kv.IKV {
+	_, err := logger.NewLogger("dev", nil, nil)
+	assert.NoError(t, err)
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	t.Cleanup(mr.Close)
+	return kv.NewRedis(redispkg.NewClient(redispkg.Config{Address: mr.Addr()}))
+}
+
+func TestRegistry(t *testing.T) {
+	kvStore := newTestKV(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	node1 := NewRegistry(kvStore, Config{Enabled: true, NodeID: "node1"}, "10.0.0.1:5011")
+	node2 := NewRegistry(kvStore, Config{Enabled: true, NodeID: "node2", Address: "propeller-2:5011"}, "0.0.0.0:5011")
+	done := make(chan struct{})
+	go func() {
+		_ = node1.Run(ctx, func() int { return 3 })
+		close(done)
+	}()
+	node2.heartbeat(ctx, 5)
+
+	// a node which missed its heartbeats
+	stale, err := json.Marshal(Node{ID: "node3", LastSeenAt: time.Now().Add(-time.Minute)})
+	assert.NoError(t, err)
+	assert.NoError(t, kvStore.Store(ctx, nodesKey, "node3", string(stale)))
+
+	var nodes []Node
+	assert.Eventually(t, func() bool {
+		nodes, err = node2.ListNodes(ctx)
+		return err == nil && len(nodes) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "node1", nodes[0].ID)
+	assert.Equal(t, "10.0.0.1:5011", nodes[0].Address)
+	assert.Equal(t, 3, nodes[0].Connections)
+	assert.Equal(t, "node2", nodes[1].ID)
+	assert.Equal(t, "propeller-2:5011", nodes[1].Address)
+	assert.Equal(t, 5, nodes[1].Connections)
+
+	// the stale node is deleted
+	v, err := kvStore.Load(ctx, nodesKey)
+	assert.NoError(t, err)
+	assert.NotContains(t, v, "node3")
+
+	// a stopped node deregisters itself
+	cancel()
+	<-done
+	nodes, err = node2.ListNodes(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, nodes, 1) {
+		assert.Equal(t, "node2", nodes[0].ID)
+	}
+}
+
+func TestRegistry_Disabled(t *testing.T) {
+	registry := NewRegistry(newTestKV(t), Config{}, "")
+	assert.NotEmpty(t, registry.NodeID())
+	assert.NoError(t, registry.Run(context.Background(), func() int { return 0 }))
+	_, err := registry.ListNodes(context.Background())
+	assert.Error(t, err)
+}
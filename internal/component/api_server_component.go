@@ -6,9 +6,12 @@ import (
 	"net/http"
 	_ "net/http/pprof"
 
+	"github.com/CRED-CLUB/propeller/internal/authz"
+	"github.com/CRED-CLUB/propeller/internal/cluster"
 	"github.com/CRED-CLUB/propeller/internal/component/apiserver"
 	"github.com/CRED-CLUB/propeller/internal/config"
 	"github.com/CRED-CLUB/propeller/internal/grpcserver"
+	inboxpkg "github.com/CRED-CLUB/propeller/internal/inbox"
 	kvpkg "github.com/CRED-CLUB/propeller/internal/kv"
 	"github.com/CRED-CLUB/propeller/internal/perror"
 	pubsubpkg "github.com/CRED-CLUB/propeller/internal/pubsub"
@@ -52,7 +55,7 @@ func (web *APIServer) Start(ctx context.Context) error {
 	// initiates an error group
 	grp, gctx := errgroup.WithContext(ctx)
 
-	pubsub, err := pubsubpkg.New(ctx, web.config.Broker)
+	pubsub, controlPubSub, err := pubsubpkg.New(ctx, web.config.Broker, web.config.SendQueue)
 	if err != nil {
 		return err
 	}
@@ -61,7 +64,19 @@ func (web *APIServer) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	pushService := push.NewService(pubsub, kv, web.config)
+	var inbox inboxpkg.IInbox
+	if web.config.Inbox.Enabled {
+		inbox, err = inboxpkg.New(ctx, web.config.Broker, web.config.Inbox)
+		if err != nil {
+			return err
+		}
+	}
+	authorizer, err := authz.New(web.config.Authz)
+	if err != nil {
+		return err
+	}
+	nodes := cluster.NewRegistry(kv, web.config.Cluster, web.config.Grpc.Address)
+	pushService := push.NewService(pubsub, controlPubSub, kv, inbox, authorizer, nodes, web.config)
 	cancelCtx, cancelFunc := context.WithCancel(gctx)
 	pushGrpcService := apiserver.NewPushServer(pushService, web.config)
 
@@ -102,6 +117,9 @@ func (web *APIServer) Start(ctx context.Context) error {
 		err := s.Run(gctx)
 		return err
 	})
+	grp.Go(func() error {
+		return nodes.Run(gctx, pushService.Sessions().Len)
+	})
 	httpSrv := &http.Server{Addr: fmt.Sprintf(":%s", (web.config.HTTP.Port))}
 	ws := apiserver.WebSocketServerWrapper{PushServer: pushGrpcService, Ctx: cancelCtx, CancelFunc: cancelFunc}
 	grp.Go(func() error {
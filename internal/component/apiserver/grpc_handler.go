@@ -2,19 +2,22 @@ package apiserver
 
 import (
 	"context"
-	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
-
+	"github.com/CRED-CLUB/propeller/internal/ack"
 	"github.com/CRED-CLUB/propeller/internal/config"
 	"github.com/CRED-CLUB/propeller/internal/perror"
 	"github.com/CRED-CLUB/propeller/internal/push"
+	"github.com/CRED-CLUB/propeller/pkg/broker"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
 	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // NewPushServer returns the web server for push
@@ -25,9 +28,8 @@ func NewPushServer(svc *push.Service, config config.Config) *PushServer {
 // PushServer implements push web component
 type PushServer struct {
 	pushv1.UnimplementedPushServiceServer
-	svc            *push.Service
-	conf           config.Config
-	testCancelFunc context.CancelFunc
+	svc  *push.Service
+	conf config.Config
 }
 
 // Channel to the push server and receive streaming response
@@ -44,12 +46,26 @@ func (ps *PushServer) Channel(srv pushv1.PushService_ChannelServer) error {
 	})
 	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
 
-	clientSubscription, err := ps.svc.AsyncClientSubscribe(loggerCtx, clientID, device)
+	options := push.ChannelOptions{
+		Cursor:       ps.getHeader(srv.Context(), ps.conf.ResumeCursorHeader),
+		TopicRestore: push.TopicRestoreMode(ps.getHeader(srv.Context(), ps.conf.TopicRestoreHeader)),
+		Topics:       ps.getConnectTopics(srv.Context()),
+	}
+
+	session, connectResult, err := ps.svc.AsyncClientSubscribe(loggerCtx, clientID, device, options)
 	if err != nil {
 		logger.Ctx(loggerCtx).Info("error in subscribing to client", "error", err.Error())
 		return perror.ToGRPCError(err)
 	}
 
+	// events sent on the channel are tracked for redelivery until acked by the client
+	var redeliveryTick <-chan time.Time
+	if session.AckTracker != nil {
+		ticker := time.NewTicker(ack.CheckInterval)
+		defer ticker.Stop()
+		redeliveryTick = ticker.C
+	}
+
 	rc := make(chan *pushv1.ChannelRequest)
 	go receiveLoop(loggerCtx, rc, srv)
 
@@ -61,6 +77,8 @@ func (ps *PushServer) Channel(srv pushv1.PushService_ChannelServer) error {
 				Message:   nil,
 				ErrorType: "",
 			},
+			Topics:    connectResult.RestoredTopics,
+			TopicAcks: getTopicSubscriptionAcks(connectResult.Topics),
 		},
 	}})
 	if err != nil {
@@ -72,52 +90,77 @@ func (ps *PushServer) Channel(srv pushv1.PushService_ChannelServer) error {
 		case <-srv.Context().Done():
 			logger.Ctx(loggerCtx).Debugw("client closed connection")
 			// create new context as input one is already cancelled
-			_ = ps.svc.ClientUnsubscribe(context.WithoutCancel(loggerCtx), clientID, clientSubscription, device)
+			_ = ps.svc.ClientUnsubscribe(context.WithoutCancel(loggerCtx), session)
 			return nil
-		case err := <-clientSubscription.ErrChan:
+		case err := <-session.Subscription.ErrChan:
 			logger.Ctx(loggerCtx).Errorw("error in subscriber", "error", err.Error())
-		case topicEventReceived := <-clientSubscription.TopicEventChan:
+		case <-session.Subscription.Overflow:
+			logger.Ctx(loggerCtx).Infow("disconnecting slow consumer")
+			_ = ps.svc.ClientUnsubscribe(context.WithoutCancel(loggerCtx), session)
+			return perror.ToGRPCError(perror.New(perror.ResourceExhausted, "disconnected as slow consumer"))
+		case <-session.ControlSubscription.Overflow:
+			// control events are not dropped, the channel is closed so that it is not left out of sync
+			logger.Ctx(loggerCtx).Infow("disconnecting slow consumer of control events")
+			_ = ps.svc.ClientUnsubscribe(context.WithoutCancel(loggerCtx), session)
+			return perror.ToGRPCError(perror.New(perror.ResourceExhausted, "disconnected as slow consumer"))
+		case controlEventReceived := <-session.ControlSubscription.TopicEventChan:
+			disconnect := ps.handleControlEvent(loggerCtx, srv, controlEventReceived, session)
+			if disconnect != nil {
+				logger.Ctx(loggerCtx).Infow("disconnecting client", "reason", disconnect.GetReason())
+				_ = ps.svc.ClientUnsubscribe(context.WithoutCancel(loggerCtx), session)
+				return perror.ToGRPCError(perror.Newf(perror.Aborted, "disconnected by server: %s", disconnect.GetReason()))
+			}
+		case topicEventReceived := <-session.Subscription.TopicEventChan:
 			protoEvent := &pushv1.Event{}
-			err := proto.Unmarshal(topicEventReceived.Event, protoEvent)
+			var err error
+			if ps.svc.IsBroadcastTopic(topicEventReceived.Topic) {
+				protoEvent, err = ps.svc.GetBroadcastEvent(loggerCtx, topicEventReceived.Event, session.Device)
+			} else {
+				err = proto.Unmarshal(topicEventReceived.Event, protoEvent)
+			}
 			if err != nil {
 				logger.Ctx(loggerCtx).Errorf("error in converting to proto %v", err)
 				break
 			}
-			if ps.svc.IsDeviceValidationMessage(protoEvent.Name) {
-				e := pushv1.Event{
-					Name:       fmt.Sprintf("%s#%s#%s", clientID, protoEvent.GetData().Value, "resp"),
-					FormatType: 0,
-					Data: &anypb.Any{
-						TypeUrl: "",
-						Value:   protoEvent.GetData().Value,
-					},
-				}
-				eventBytes, err := proto.Marshal(&e)
-				if err != nil {
-					logger.Ctx(loggerCtx).Errorw("error in marshaling event", "error", err.Error())
-					break
-				}
-				err = ps.svc.PublishToTopic(loggerCtx, push.SendEventToTopicRequest{
-					Topic:     fmt.Sprintf("%s#%s#%s", clientID, protoEvent.GetData().Value, "resp"),
-					EventName: protoEvent.Name,
-					Event:     eventBytes,
-				})
-				if err != nil {
-					logger.Ctx(loggerCtx).Errorw("error in publish device validation resp", "error", err.Error())
-				}
+			// broadcast not meant for this device, or a control event sent to the client subject
+			if protoEvent == nil || protoEvent.GetName() == push.ControlEventName {
+				break
+			}
+			if ps.svc.IsEventExpired(protoEvent, time.Now()) {
+				logger.Ctx(loggerCtx).Debugw("dropping expired event", "eventName", protoEvent.GetName())
 				break
 			}
-			err = srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_ChannelEvent{
-				ChannelEvent: &pushv1.ChannelEvent{Event: protoEvent, Topic: topicEventReceived.Topic},
-			}})
+			if ps.svc.IsEventFiltered(session, topicEventReceived.Topic, protoEvent) {
+				break
+			}
+			channelEvent := &pushv1.ChannelEvent{
+				UniqueId: uuid.NewString(),
+				Topic:    topicEventReceived.Topic,
+				Event:    protoEvent,
+				Cursor:   topicEventReceived.ID,
+			}
+			err = sendChannelEvent(srv, channelEvent)
 			if err != nil {
 				logger.Ctx(loggerCtx).Errorw("error in send", "error", err.Error())
 			}
+			// track even if the send failed, the event is redelivered after ack timeout
+			if session.AckTracker != nil {
+				session.AckTracker.Track(channelEvent, time.Now())
+			}
+			session.RecordEventSent()
 			ps.svc.ConfirmEventReceipt(loggerCtx, protoEvent.Name)
-			logger.Ctx(loggerCtx).Debugw("sent event", "eventName", protoEvent.GetName())
+			logger.Ctx(loggerCtx).Debugw("sent event", "eventName", protoEvent.GetName(), "uniqueID", channelEvent.UniqueId)
+		case <-redeliveryTick:
+			for _, channelEvent := range session.AckTracker.Due(time.Now()) {
+				err := sendChannelEvent(srv, channelEvent)
+				if err != nil {
+					logger.Ctx(loggerCtx).Errorw("error in redelivery", "error", err.Error())
+				}
+				logger.Ctx(loggerCtx).Debugw("redelivered event", "eventName", channelEvent.GetEvent().GetName(), "uniqueID", channelEvent.UniqueId)
+			}
 		case req := <-rc:
 			logger.Ctx(loggerCtx).Infow("received from client", "req", req)
-			ps.HandleReceivedPayload(loggerCtx, srv, req, clientSubscription)
+			ps.HandleReceivedPayload(loggerCtx, srv, req, session)
 		}
 	}
 }
@@ -138,7 +181,7 @@ func (ps *PushServer) SendEventToClientChannel(ctx context.Context, req *pushv1.
 		return nil, perror.ToGRPCError(err)
 	}
 
-	err = ps.svc.PublishToClient(loggerCtx, reqModel)
+	duplicate, err := ps.svc.PublishToClient(loggerCtx, reqModel)
 	if err != nil {
 		return nil, perror.ToGRPCError(err)
 	}
@@ -150,6 +193,7 @@ func (ps *PushServer) SendEventToClientChannel(ctx context.Context, req *pushv1.
 			Message:   nil,
 			ErrorType: "",
 		},
+		Duplicate: duplicate,
 	}, nil
 }
 
@@ -170,7 +214,7 @@ func (ps *PushServer) SendEventToClientDeviceChannel(ctx context.Context, req *p
 		return nil, perror.ToGRPCError(err)
 	}
 
-	err = ps.svc.PublishToClientWithDevice(loggerCtx, reqModel)
+	duplicate, err := ps.svc.PublishToClientWithDevice(loggerCtx, reqModel)
 	if err != nil {
 		return nil, perror.ToGRPCError(err)
 	}
@@ -182,10 +226,95 @@ func (ps *PushServer) SendEventToClientDeviceChannel(ctx context.Context, req *p
 			Message:   nil,
 			ErrorType: "",
 		},
+		Duplicate: duplicate,
 	}, nil
 
 }
 
+// SendEventToClients sends an event to multiple clients
+func (ps *PushServer) SendEventToClients(ctx context.Context, req *pushv1.SendEventToClientsRequest) (*pushv1.SendEventToClientsResponse, error) {
+	// prepare contextual logger with  fields
+	derivedCtx := context.WithValue(ctx, logger.CtxKeyType("meta"), map[string]string{
+		"eventName": req.GetEvent().GetName(),
+	})
+	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
+
+	reqModel := push.SendEventToClientsRequest{}
+
+	err := reqModel.PopulateFromProto(loggerCtx, req)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	statuses, err := ps.svc.PublishToClients(loggerCtx, reqModel)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	success := true
+	recipientStatuses := make([]*pushv1.RecipientStatus, 0, len(statuses))
+	for _, s := range statuses {
+		status := &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		}
+		if s.Err != nil {
+			success = false
+			status = getFailureResponseStatus(s.Err)
+		}
+		recipientStatuses = append(recipientStatuses, &pushv1.RecipientStatus{
+			Recipient: s.Recipient.ToProto(),
+			Status:    status,
+			Duplicate: s.Duplicate,
+		})
+	}
+
+	return &pushv1.SendEventToClientsResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   success,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+		RecipientStatuses: recipientStatuses,
+	}, nil
+}
+
+// SendRequestToClient sends an event to a client and returns the reply of the client
+func (ps *PushServer) SendRequestToClient(ctx context.Context, req *pushv1.SendRequestToClientRequest) (*pushv1.SendRequestToClientResponse, error) {
+	// prepare contextual logger with  fields
+	derivedCtx := context.WithValue(ctx, logger.CtxKeyType("meta"), map[string]string{
+		"clientId":  req.ClientId,
+		"deviceId":  req.DeviceId,
+		"eventName": req.GetEvent().GetName(),
+	})
+	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
+
+	reqModel := push.SendRequestToClientRequest{}
+
+	err := reqModel.PopulateFromProto(loggerCtx, req)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	reply, err := ps.svc.RequestClient(loggerCtx, reqModel)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	return &pushv1.SendRequestToClientResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+		Reply: reply,
+	}, nil
+}
+
 // SendEventToTopic sends event to a topic
 func (ps *PushServer) SendEventToTopic(ctx context.Context, req *pushv1.SendEventToTopicRequest) (*pushv1.SendEventToTopicResponse, error) {
 	// prepare contextual logger with  fields
@@ -202,7 +331,7 @@ func (ps *PushServer) SendEventToTopic(ctx context.Context, req *pushv1.SendEven
 		return nil, perror.ToGRPCError(err)
 	}
 
-	err = ps.svc.PublishToTopic(loggerCtx, reqModel)
+	duplicate, err := ps.svc.PublishToTopic(loggerCtx, reqModel)
 	if err != nil {
 		return nil, perror.ToGRPCError(err)
 	}
@@ -214,6 +343,7 @@ func (ps *PushServer) SendEventToTopic(ctx context.Context, req *pushv1.SendEven
 			Message:   nil,
 			ErrorType: "",
 		},
+		Duplicate: duplicate,
 	}, nil
 }
 
@@ -254,6 +384,37 @@ func (ps *PushServer) SendEventToTopics(ctx context.Context, req *pushv1.SendEve
 	}, nil
 }
 
+// BroadcastEvent sends event to every connected client
+func (ps *PushServer) BroadcastEvent(ctx context.Context, req *pushv1.BroadcastEventRequest) (*pushv1.BroadcastEventResponse, error) {
+	// prepare contextual logger with  fields
+	derivedCtx := context.WithValue(ctx, logger.CtxKeyType("meta"), map[string]string{
+		"eventName": req.GetEvent().GetName(),
+	})
+	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
+
+	reqModel := push.BroadcastEventRequest{}
+
+	err := reqModel.PopulateFromProto(loggerCtx, req)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	duplicate, err := ps.svc.Broadcast(loggerCtx, reqModel)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	return &pushv1.BroadcastEventResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+		Duplicate: duplicate,
+	}, nil
+}
+
 // GetClientActiveDevices returns currently online devices for a client
 func (ps *PushServer) GetClientActiveDevices(ctx context.Context, req *pushv1.GetClientActiveDevicesRequest) (*pushv1.GetClientActiveDevicesResponse, error) {
 	// prepare contextual logger with  fields
@@ -292,70 +453,414 @@ func (ps *PushServer) GetClientActiveDevices(ctx context.Context, req *pushv1.Ge
 	}, nil
 }
 
-// HandleReceivedPayload handles the received requests from the client
-func (ps *PushServer) HandleReceivedPayload(ctx context.Context, srv pushv1.PushService_ChannelServer, receivedRequest *pushv1.ChannelRequest, clientSubscription *subscription.Subscription) {
-	testCtx, testCancelFunc := context.WithCancel(ctx)
-	ps.testCancelFunc = testCancelFunc
+// SubscribeClientToTopic subscribes the channels of a client, or a device of the client, to a topic on every node
+func (ps *PushServer) SubscribeClientToTopic(ctx context.Context, req *pushv1.SubscribeClientToTopicRequest) (*pushv1.SubscribeClientToTopicResponse, error) {
+	// prepare contextual logger with  fields
+	derivedCtx := context.WithValue(ctx, logger.CtxKeyType("meta"), map[string]string{
+		"clientId": req.ClientId,
+		"deviceId": req.DeviceId,
+		"topic":    req.Topic,
+	})
+	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
+
+	reqModel := push.SubscribeClientToTopicRequest{}
+
+	err := reqModel.PopulateFromProto(loggerCtx, req)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	err = ps.svc.SubscribeClientToTopic(loggerCtx, reqModel)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	return &pushv1.SubscribeClientToTopicResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+	}, nil
+}
+
+// UnsubscribeClientFromTopic unsubscribes the channels of a client, or a device of the client, from a topic on every node
+func (ps *PushServer) UnsubscribeClientFromTopic(ctx context.Context, req *pushv1.UnsubscribeClientFromTopicRequest) (*pushv1.UnsubscribeClientFromTopicResponse, error) {
+	// prepare contextual logger with  fields
+	derivedCtx := context.WithValue(ctx, logger.CtxKeyType("meta"), map[string]string{
+		"clientId": req.ClientId,
+		"deviceId": req.DeviceId,
+		"topic":    req.Topic,
+	})
+	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
+
+	reqModel := push.UnsubscribeClientFromTopicRequest{}
+
+	err := reqModel.PopulateFromProto(loggerCtx, req)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
 
+	err = ps.svc.UnsubscribeClientFromTopic(loggerCtx, reqModel)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	return &pushv1.UnsubscribeClientFromTopicResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+	}, nil
+}
+
+// DisconnectClient closes the channels of a client, or a device of the client, on every node
+func (ps *PushServer) DisconnectClient(ctx context.Context, req *pushv1.DisconnectClientRequest) (*pushv1.DisconnectClientResponse, error) {
+	// prepare contextual logger with  fields
+	derivedCtx := context.WithValue(ctx, logger.CtxKeyType("meta"), map[string]string{
+		"clientId": req.ClientId,
+		"deviceId": req.DeviceId,
+	})
+	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
+
+	reqModel := push.DisconnectClientRequest{}
+
+	err := reqModel.PopulateFromProto(loggerCtx, req)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	err = ps.svc.DisconnectClient(loggerCtx, reqModel)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	return &pushv1.DisconnectClientResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+	}, nil
+}
+
+// GetClientsOnlineStatus returns the online status of multiple clients
+func (ps *PushServer) GetClientsOnlineStatus(ctx context.Context, req *pushv1.GetClientsOnlineStatusRequest) (*pushv1.GetClientsOnlineStatusResponse, error) {
+	// prepare contextual logger with  fields
+	derivedCtx := context.WithValue(ctx, logger.CtxKeyType("meta"), map[string]string{
+		"clientCount": strconv.Itoa(len(req.ClientIds)),
+	})
+	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
+
+	reqModel := push.GetClientsOnlineStatusRequest{}
+
+	err := reqModel.PopulateFromProto(loggerCtx, req)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	r, err := ps.svc.GetClientsOnlineStatus(loggerCtx, reqModel)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+	statuses := make([]*pushv1.ClientOnlineStatus, len(r))
+	for i := range r {
+		statuses[i] = r[i].ToProto()
+	}
+
+	return &pushv1.GetClientsOnlineStatusResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+		Statuses: statuses,
+	}, nil
+}
+
+// GetTopicPresence returns the clients currently subscribed to a topic
+func (ps *PushServer) GetTopicPresence(ctx context.Context, req *pushv1.GetTopicPresenceRequest) (*pushv1.GetTopicPresenceResponse, error) {
+	// prepare contextual logger with  fields
+	derivedCtx := context.WithValue(ctx, logger.CtxKeyType("meta"), map[string]string{
+		"topic": req.Topic,
+	})
+	loggerCtx := context.WithValue(derivedCtx, logger.CtxKey, logger.WithContext(derivedCtx, []logger.CtxKeyType{"meta"}))
+
+	reqModel := push.GetTopicPresenceRequest{}
+
+	err := reqModel.PopulateFromProto(loggerCtx, req)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+
+	r, err := ps.svc.GetTopicPresence(loggerCtx, reqModel)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+	var members []*pushv1.TopicPresenceMember
+	for i := range r {
+		members = append(members, r[i].ToProto())
+	}
+
+	return &pushv1.GetTopicPresenceResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+		Members: members,
+	}, nil
+}
+
+// ListNodes returns the live nodes of the cluster
+func (ps *PushServer) ListNodes(ctx context.Context, req *pushv1.ListNodesRequest) (*pushv1.ListNodesResponse, error) {
+	r, err := ps.svc.ListNodes(ctx)
+	if err != nil {
+		return nil, perror.ToGRPCError(err)
+	}
+	var nodes []*pushv1.Node
+	for i := range r {
+		nodes = append(nodes, r[i].ToProto())
+	}
+
+	return &pushv1.ListNodesResponse{
+		Status: &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		},
+		Nodes: nodes,
+	}, nil
+}
+
+// HandleReceivedPayload handles the received requests from the client of the session
+func (ps *PushServer) HandleReceivedPayload(ctx context.Context, srv pushv1.PushService_ChannelServer, receivedRequest *pushv1.ChannelRequest, session *push.Session) {
 	switch receivedRequest.Request.(type) {
+	case *pushv1.ChannelRequest_ChannelEvent:
+		channelEvent := receivedRequest.GetChannelEvent()
+		session.RecordEventReceived()
+		status := &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		}
+		var err error
+		if ps.svc.IsReply(channelEvent.GetEvent()) {
+			err = ps.svc.PublishReply(ctx, session, channelEvent.GetEvent())
+		} else {
+			err = ps.svc.PublishUpstream(ctx, session, channelEvent)
+		}
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error in handling channel event", "uniqueID", channelEvent.GetUniqueId(), "error", err.Error())
+			status = getFailureResponseStatus(err)
+		}
+		_ = srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_ChannelEventAck{ChannelEventAck: &pushv1.ChannelEventAck{
+			UniqueId: channelEvent.GetUniqueId(),
+			Status:   status,
+		}}})
+	case *pushv1.ChannelRequest_ChannelEventAck:
+		// acks are ignored if redelivery is disabled
+		if session.AckTracker == nil {
+			return
+		}
+		uniqueID := receivedRequest.GetChannelEventAck().GetUniqueId()
+		if !session.AckTracker.Ack(uniqueID) {
+			logger.Ctx(ctx).Debugw("ack received for unknown event", "uniqueID", uniqueID)
+		}
 	case *pushv1.ChannelRequest_TopicSubscriptionRequest:
 		topic := receivedRequest.GetTopicSubscriptionRequest().GetTopic()
-		err := ps.svc.TopicSubscribe(ctx, topic, clientSubscription)
+		var filter *push.EventFilter
+		if protoFilter := receivedRequest.GetTopicSubscriptionRequest().GetFilter(); protoFilter != nil {
+			filter = &push.EventFilter{}
+			err := filter.PopulateFromProto(ctx, protoFilter)
+			if err != nil {
+				_ = sendTopicSubscriptionAck(srv, topic, err)
+				return
+			}
+		}
+		err := ps.svc.TopicSubscribe(ctx, session, topic)
 		if err != nil {
 			logger.Ctx(ctx).Errorw("error in subscribing to topic", "topic", topic, "error", err.Error())
-			_ = srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_TopicSubscriptionRequestAck{TopicSubscriptionRequestAck: &pushv1.TopicSubscriptionRequestAck{
-				Topic: topic,
-				Status: &pushv1.ResponseStatus{
-					Success:   false,
-					ErrorCode: "",
-					Message:   map[string]string{"message": err.Error()},
-
-					ErrorType: "",
-				},
-			}}})
+			_ = sendTopicSubscriptionAck(srv, topic, err)
 			return
 		}
+		session.Filters.SetTopicFilter(topic, filter)
 		if ps.conf.SendTestPayloadToTopic == true {
-			go ps.svc.TriggerTestPayloadToTopic(testCtx, topic)
+			ps.svc.StartTestPayloadToTopic(ctx, session, topic)
 		}
-		_ = srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_TopicSubscriptionRequestAck{TopicSubscriptionRequestAck: &pushv1.TopicSubscriptionRequestAck{
-			Topic: topic,
-			Status: &pushv1.ResponseStatus{
-				Success:   true,
-				ErrorCode: "",
-				Message:   nil,
-				ErrorType: "",
-			},
-		}}})
+		_ = sendTopicSubscriptionAck(srv, topic, nil)
 	case *pushv1.ChannelRequest_TopicUnsubscriptionRequest:
 		topic := receivedRequest.GetTopicUnsubscriptionRequest().GetTopic()
-		err := ps.svc.TopicUnsubscribe(ctx, topic, clientSubscription)
+		err := ps.svc.TopicUnsubscribe(ctx, session, topic)
 		if err != nil {
 			logger.Ctx(ctx).Errorw("error in unsubscribing to topic", "topic", topic, "error", err.Error())
-			_ = srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_TopicUnsubscriptionRequestAck{TopicUnsubscriptionRequestAck: &pushv1.TopicUnsubscriptionRequestAck{
-				Topic: topic,
-				Status: &pushv1.ResponseStatus{
-					Success:   false,
-					ErrorCode: "",
-					Message:   map[string]string{"message": err.Error()},
-					ErrorType: "",
-				},
-			}}})
+			_ = sendTopicUnsubscriptionAck(srv, topic, err)
 			return
 		}
-		if ps.conf.SendTestPayloadToTopic == true {
-			ps.testCancelFunc()
+		session.Filters.SetTopicFilter(topic, nil)
+		_ = sendTopicUnsubscriptionAck(srv, topic, nil)
+	case *pushv1.ChannelRequest_ChannelFilterRequest:
+		var filter *push.EventFilter
+		if protoFilter := receivedRequest.GetChannelFilterRequest().GetFilter(); protoFilter != nil {
+			filter = &push.EventFilter{}
+			err := filter.PopulateFromProto(ctx, protoFilter)
+			if err != nil {
+				_ = sendChannelFilterAck(srv, err)
+				return
+			}
 		}
-		_ = srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_TopicUnsubscriptionRequestAck{TopicUnsubscriptionRequestAck: &pushv1.TopicUnsubscriptionRequestAck{
-			Topic: topic,
-			Status: &pushv1.ResponseStatus{
-				Success:   true,
-				ErrorCode: "",
-				Message:   nil,
-				ErrorType: "",
-			},
-		}}})
+		session.Filters.SetChannelFilter(filter)
+		_ = sendChannelFilterAck(srv, nil)
+	}
+}
+
+// handleControlEvent acts on a control event for the channel, the disconnect is returned if the channel is to be closed
+func (ps *PushServer) handleControlEvent(ctx context.Context, srv pushv1.PushService_ChannelServer, topicEvent broker.TopicEvent, session *push.Session) *pushv1.DisconnectControl {
+	protoEvent := &pushv1.Event{}
+	err := proto.Unmarshal(topicEvent.Event, protoEvent)
+	if err != nil {
+		logger.Ctx(ctx).Errorf("error in converting to proto %v", err)
+		return nil
+	}
+	controlEvent, err := ps.svc.GetControlEvent(ctx, protoEvent)
+	if err != nil || controlEvent == nil {
+		return nil
+	}
+	// a control event of other channels of the client, e.g. on eviction
+	if !ps.svc.IsControlEventTarget(controlEvent, session) {
+		return nil
+	}
+	disconnect := controlEvent.GetDisconnect()
+	if disconnect == nil {
+		ps.handleTopicControl(ctx, srv, controlEvent, session)
+		return nil
+	}
+	if disconnect.GetEvent() != nil {
+		err = sendChannelEvent(srv, &pushv1.ChannelEvent{
+			UniqueId: uuid.NewString(),
+			Topic:    session.ClientID,
+			Event:    disconnect.GetEvent(),
+		})
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error in sending disconnect event", "error", err.Error())
+		}
+	}
+	return disconnect
+}
+
+// handleTopicControl subscribes or unsubscribes the channel to a topic on request of the backend,
+// the client is notified with the same acks as for its own requests
+func (ps *PushServer) handleTopicControl(ctx context.Context, srv pushv1.PushService_ChannelServer, controlEvent *pushv1.ControlEvent, session *push.Session) {
+	switch control := controlEvent.GetControl().(type) {
+	case *pushv1.ControlEvent_Subscribe:
+		topic := control.Subscribe.GetTopic()
+		err := ps.svc.ServerTopicSubscribe(ctx, session, topic)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error in subscribing to topic", "topic", topic, "error", err.Error())
+		}
+		_ = sendTopicSubscriptionAck(srv, topic, err)
+	case *pushv1.ControlEvent_Unsubscribe:
+		topic := control.Unsubscribe.GetTopic()
+		err := ps.svc.TopicUnsubscribe(ctx, session, topic)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error in unsubscribing to topic", "topic", topic, "error", err.Error())
+		} else {
+			session.Filters.SetTopicFilter(topic, nil)
+		}
+		_ = sendTopicUnsubscriptionAck(srv, topic, err)
+	}
+}
+
+// getTopicSubscriptionAcks returns the acks of subscribing to the topics
+func getTopicSubscriptionAcks(statuses []push.TopicStatus) []*pushv1.TopicSubscriptionRequestAck {
+	var acks []*pushv1.TopicSubscriptionRequestAck
+	for _, s := range statuses {
+		status := &pushv1.ResponseStatus{
+			Success:   true,
+			ErrorCode: "",
+			Message:   nil,
+			ErrorType: "",
+		}
+		if s.Err != nil {
+			status = getFailureResponseStatus(s.Err)
+		}
+		acks = append(acks, &pushv1.TopicSubscriptionRequestAck{Topic: s.Topic, Status: status})
+	}
+	return acks
+}
+
+// sendTopicSubscriptionAck acks the subscription to a topic, as failed if err is set
+func sendTopicSubscriptionAck(srv pushv1.PushService_ChannelServer, topic string, err error) error {
+	status := &pushv1.ResponseStatus{
+		Success:   true,
+		ErrorCode: "",
+		Message:   nil,
+		ErrorType: "",
+	}
+	if err != nil {
+		status = getFailureResponseStatus(err)
 	}
+	return srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_TopicSubscriptionRequestAck{TopicSubscriptionRequestAck: &pushv1.TopicSubscriptionRequestAck{
+		Topic:  topic,
+		Status: status,
+	}}})
+}
+
+// sendTopicUnsubscriptionAck acks the un-subscription from a topic, as failed if err is set
+func sendTopicUnsubscriptionAck(srv pushv1.PushService_ChannelServer, topic string, err error) error {
+	status := &pushv1.ResponseStatus{
+		Success:   true,
+		ErrorCode: "",
+		Message:   nil,
+		ErrorType: "",
+	}
+	if err != nil {
+		status = getFailureResponseStatus(err)
+	}
+	return srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_TopicUnsubscriptionRequestAck{TopicUnsubscriptionRequestAck: &pushv1.TopicUnsubscriptionRequestAck{
+		Topic:  topic,
+		Status: status,
+	}}})
+}
+
+// sendChannelFilterAck acks the filter of the channel, as failed if err is set
+func sendChannelFilterAck(srv pushv1.PushService_ChannelServer, err error) error {
+	status := &pushv1.ResponseStatus{
+		Success:   true,
+		ErrorCode: "",
+		Message:   nil,
+		ErrorType: "",
+	}
+	if err != nil {
+		status = getFailureResponseStatus(err)
+	}
+	return srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_ChannelFilterRequestAck{ChannelFilterRequestAck: &pushv1.ChannelFilterRequestAck{
+		Status: status,
+	}}})
+}
+
+// getFailureResponseStatus returns a failed response status for the error
+func getFailureResponseStatus(err error) *pushv1.ResponseStatus {
+	return &pushv1.ResponseStatus{
+		Success:   false,
+		ErrorCode: "",
+		Message:   map[string]string{"message": err.Error()},
+		ErrorType: status.Code(perror.ToGRPCError(err)).String(),
+	}
+}
+
+func sendChannelEvent(srv pushv1.PushService_ChannelServer, channelEvent *pushv1.ChannelEvent) error {
+	return srv.Send(&pushv1.ChannelResponse{Response: &pushv1.ChannelResponse_ChannelEvent{
+		ChannelEvent: channelEvent,
+	}})
 }
 
 func receiveLoop(ctx context.Context, rc chan *pushv1.ChannelRequest, srv pushv1.PushService_ChannelServer) {
@@ -414,3 +919,39 @@ func (ps *PushServer) validateAndGetClientAndDeviceDetails(ctx context.Context)
 
 	return clientID, device, nil
 }
+
+// getConnectTopics returns the topics to subscribe to on connect, passed as comma separated or repeated header values
+func (ps *PushServer) getConnectTopics(ctx context.Context) []string {
+	if ps.conf.ConnectTopicsHeader == "" {
+		return nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	var topics []string
+	for _, v := range md.Get(ps.conf.ConnectTopicsHeader) {
+		for _, topic := range strings.Split(v, ",") {
+			topic = strings.TrimSpace(topic)
+			if topic != "" {
+				topics = append(topics, topic)
+			}
+		}
+	}
+	return topics
+}
+
+// getHeader returns the value of the header, if configured and passed on connect
+func (ps *PushServer) getHeader(ctx context.Context, header string) string {
+	if header == "" {
+		return ""
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if len(md.Get(header)) <= 0 {
+		return ""
+	}
+	return md.Get(header)[0]
+}
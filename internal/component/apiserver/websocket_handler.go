@@ -61,7 +61,7 @@ func (ws *WebSocketServerWrapper) Recv() (*pushv1.ChannelRequest, error) {
 		ws.CancelFunc()
 		return nil, nil
 	}
-	logger.Ctx(ws.Ctx).Debugf("receive message from server: %v, %v", req, err)
+	logger.Ctx(ws.Ctx).Debugf("receive message from server: %v, %v", &req, err)
 	return &req, err
 }
 
@@ -1,26 +1,51 @@
 package config
 
 import (
+	"github.com/CRED-CLUB/propeller/internal/ack"
+	"github.com/CRED-CLUB/propeller/internal/authz"
 	"github.com/CRED-CLUB/propeller/internal/broker"
+	"github.com/CRED-CLUB/propeller/internal/cluster"
 	"github.com/CRED-CLUB/propeller/internal/feature"
 	"github.com/CRED-CLUB/propeller/internal/grpcserver"
 	"github.com/CRED-CLUB/propeller/internal/httpserver"
+	"github.com/CRED-CLUB/propeller/internal/inbox"
+	"github.com/CRED-CLUB/propeller/internal/sendqueue"
+	"github.com/CRED-CLUB/propeller/internal/upstream"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
 )
 
 // Config stores application config
 type Config struct {
-	ServiceName             string
-	SendTestPayload         bool
-	SendTestPayloadToTopic  bool
-	Broker                  broker.Config
-	Grpc                    grpcserver.Config
-	Features                feature.Config
-	Logger                  logger.Config
-	DeviceAttributeHeaders  []string
-	ClientHeader            string
-	DeviceHeader            string
-	EnableDeviceSupport     bool
-	HTTP                    httpserver.HTTPConfig
-	EnableProfilingHandlers bool
+	ServiceName               string
+	SendTestPayload           bool
+	SendTestPayloadToTopic    bool
+	Broker                    broker.Config
+	Grpc                      grpcserver.Config
+	Features                  feature.Config
+	Logger                    logger.Config
+	DeviceAttributeHeaders    []string
+	ClientHeader              string
+	DeviceHeader              string
+	ResumeCursorHeader        string
+	TopicRestoreHeader        string
+	ConnectTopicsHeader       string
+	BroadcastTopic            string
+	IdempotencyWindowInSec    int
+	EnableDeviceSupport       bool
+	DeviceTTLInSec            int
+	MaxChannelsPerClient      int
+	MaxChannelsPerDevice      int
+	ChannelLimitPolicy        string
+	SingleActiveDevice        bool
+	EnableTopicPresence       bool
+	SendTopicPresenceEvents   bool
+	PersistTopicSubscriptions bool
+	HTTP                      httpserver.HTTPConfig
+	EnableProfilingHandlers   bool
+	Ack                       ack.Config
+	Inbox                     inbox.Config
+	Upstream                  upstream.Config
+	Authz                     authz.Config
+	SendQueue                 sendqueue.Config
+	Cluster                   cluster.Config
 }
@@ -0,0 +1,8 @@
+package inbox
+
+// Config for offline inbox
+type Config struct {
+	Enabled   bool
+	TTLInSec  int
+	MaxLength int
+}
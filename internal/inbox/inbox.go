@@ -0,0 +1,91 @@
+package inbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/broker"
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+)
+
+const (
+	defaultTTL       = 5 * time.Minute
+	defaultMaxLength = 100
+)
+
+// IInbox holds events for clients without a live channel
+type IInbox interface {
+	Push(ctx context.Context, clientID string, event []byte) error
+	Drain(ctx context.Context, clientID string) ([][]byte, error)
+}
+
+// New returns an inbox for the configured broker
+func New(ctx context.Context, brokerConfig broker.Config, config Config) (IInbox, error) {
+	base := newBaseInbox(config)
+	switch brokerConfig.Broker {
+	case "nats":
+		natsClient, err := broker.NewNATSClient(ctx, brokerConfig)
+		if err != nil {
+			return nil, err
+		}
+		return NewNats(ctx, natsClient, base)
+	case "redis":
+		redisClient, err := broker.NewRedisClient(ctx, brokerConfig)
+		if err != nil {
+			return nil, err
+		}
+		return NewRedis(redisClient, base), nil
+	}
+	pErr := perror.Newf(perror.Internal, "unknown inbox type")
+	logger.Ctx(ctx).Error(pErr.Error())
+	return nil, pErr
+}
+
+// entry is an event stored in the inbox
+type entry struct {
+	StoredAt time.Time
+	Event    []byte
+}
+
+// baseInbox holds limits and encoding common to inbox implementations
+type baseInbox struct {
+	ttl       time.Duration
+	maxLength int64
+}
+
+func newBaseInbox(config Config) baseInbox {
+	b := baseInbox{ttl: defaultTTL, maxLength: defaultMaxLength}
+	if config.TTLInSec > 0 {
+		b.ttl = time.Duration(config.TTLInSec) * time.Second
+	}
+	if config.MaxLength > 0 {
+		b.maxLength = int64(config.MaxLength)
+	}
+	return b
+}
+
+func (b baseInbox) encode(event []byte, now time.Time) ([]byte, error) {
+	return json.Marshal(entry{StoredAt: now, Event: event})
+}
+
+// decode returns events in order, skipping the ones which are older than ttl
+func (b baseInbox) decode(ctx context.Context, values [][]byte, now time.Time) [][]byte {
+	var events [][]byte
+	for _, v := range values {
+		var e entry
+		err := json.Unmarshal(v, &e)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error decoding inbox entry", "error", err.Error())
+			continue
+		}
+		if now.Sub(e.StoredAt) > b.ttl {
+			eventsExpired.Inc()
+			continue
+		}
+		events = append(events, e.Event)
+	}
+	eventsDrained.Add(float64(len(events)))
+	return events
+}
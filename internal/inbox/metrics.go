@@ -0,0 +1,23 @@
+package inbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	eventsStored = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_inbox_events_stored_total",
+		Help: "Total number of events stored in inbox for offline clients",
+	})
+
+	eventsDrained = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_inbox_events_drained_total",
+		Help: "Total number of events drained from inbox on client connect",
+	})
+
+	eventsExpired = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_inbox_events_expired_total",
+		Help: "Total number of inbox events dropped as they were older than TTL",
+	})
+)
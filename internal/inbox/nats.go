@@ -0,0 +1,54 @@
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	natsclient "github.com/CRED-CLUB/propeller/pkg/broker/nats"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+)
+
+// Nats inbox stores events in a JetStream stream with a subject per client
+type Nats struct {
+	baseInbox
+	inbox *natsclient.Inbox
+}
+
+// NewNats returns NATS inbox
+func NewNats(ctx context.Context, conn *natsclient.Client, base baseInbox) (IInbox, error) {
+	stream, err := natsclient.NewJetStream(ctx, conn, 0)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error creating nats jetstream %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	inbox, err := stream.CreateInbox(ctx, "inbox", base.maxLength, base.ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &Nats{base, inbox}, nil
+}
+
+// Push an event to the client inbox
+func (n *Nats) Push(ctx context.Context, clientID string, event []byte) error {
+	v, err := n.encode(event, time.Now())
+	if err != nil {
+		return err
+	}
+	err = n.inbox.Append(ctx, clientID, v)
+	if err != nil {
+		return err
+	}
+	eventsStored.Inc()
+	return nil
+}
+
+// Drain the client inbox
+func (n *Nats) Drain(ctx context.Context, clientID string) ([][]byte, error) {
+	values, err := n.inbox.Drain(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return n.decode(ctx, values, time.Now()), nil
+}
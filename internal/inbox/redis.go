@@ -0,0 +1,51 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
+)
+
+// Redis inbox stores events in a capped list per client
+type Redis struct {
+	baseInbox
+	redisClient *redispkg.Client
+}
+
+// NewRedis returns redis inbox
+func NewRedis(client *redispkg.Client, base baseInbox) IInbox {
+	return &Redis{base, client}
+}
+
+// Push an event to the client inbox
+func (r *Redis) Push(ctx context.Context, clientID string, event []byte) error {
+	v, err := r.encode(event, time.Now())
+	if err != nil {
+		return err
+	}
+	err = r.redisClient.RPushCapped(ctx, inboxKey(clientID), r.maxLength, r.ttl, v)
+	if err != nil {
+		return err
+	}
+	eventsStored.Inc()
+	return nil
+}
+
+// Drain the client inbox
+func (r *Redis) Drain(ctx context.Context, clientID string) ([][]byte, error) {
+	values, err := r.redisClient.LPopAll(ctx, inboxKey(clientID))
+	if err != nil {
+		return nil, err
+	}
+	entries := make([][]byte, 0, len(values))
+	for _, v := range values {
+		entries = append(entries, []byte(v))
+	}
+	return r.decode(ctx, entries, time.Now()), nil
+}
+
+func inboxKey(clientID string) string {
+	return fmt.Sprintf("%s#%s", clientID, "inbox")
+}
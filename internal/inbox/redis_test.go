@@ -0,0 +1,65 @@
+package inbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedis_PushAndDrain(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	ctx := context.Background()
+	client := redispkg.NewClient(redispkg.Config{Address: mr.Addr()})
+	inbox := NewRedis(client, newBaseInbox(Config{Enabled: true, TTLInSec: 60, MaxLength: 2}))
+
+	for _, e := range []string{"e1", "e2", "e3"} {
+		err = inbox.Push(ctx, "client", []byte(e))
+		assert.NoError(t, err)
+	}
+
+	// oldest event is trimmed beyond max length
+	events, err := inbox.Drain(ctx, "client")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("e2"), []byte("e3")}, events)
+
+	// inbox is empty once drained
+	events, err = inbox.Drain(ctx, "client")
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestBaseInbox_DecodeSkipsExpired(t *testing.T) {
+	// Initialize logger for tests
+	serviceKV := map[string]interface{}{
+		"serviceName":   "test-service",
+		"gitCommitHash": "test-hash",
+	}
+	_, err := logger.NewLogger("dev", serviceKV, nil)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	base := newBaseInbox(Config{Enabled: true, TTLInSec: 60})
+	now := time.Now()
+
+	stale, err := base.encode([]byte("stale"), now.Add(-2*time.Minute))
+	assert.NoError(t, err)
+	fresh, err := base.encode([]byte("fresh"), now.Add(-30*time.Second))
+	assert.NoError(t, err)
+
+	events := base.decode(ctx, [][]byte{stale, []byte("corrupt"), fresh}, now)
+	assert.Equal(t, [][]byte{[]byte("fresh")}, events)
+}
+
+func TestNewBaseInbox_Defaults(t *testing.T) {
+	base := newBaseInbox(Config{Enabled: true})
+	assert.Equal(t, defaultTTL, base.ttl)
+	assert.Equal(t, int64(defaultMaxLength), base.maxLength)
+}
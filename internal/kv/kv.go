@@ -2,6 +2,7 @@ package kv
 
 import (
 	"context"
+	"time"
 
 	"github.com/CRED-CLUB/propeller/internal/broker"
 	"github.com/CRED-CLUB/propeller/internal/perror"
@@ -12,7 +13,12 @@ import (
 type IKV interface {
 	Store(ctx context.Context, key string, field string, attrs string) error
 	Load(ctx context.Context, key string) (map[string]string, error)
+	// LoadBulk loads values of the keys in order, batching the reads where the store allows
+	LoadBulk(ctx context.Context, keys ...string) ([]map[string]string, error)
 	Delete(ctx context.Context, key string, fields ...string) error
+	// StoreIfNotExists sets a key which expires after ttl, returns false if the key exists
+	StoreIfNotExists(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	DeleteKey(ctx context.Context, key string) error
 }
 
 // New KV
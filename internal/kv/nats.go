@@ -3,7 +3,13 @@ package kv
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/gob"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/CRED-CLUB/propeller/internal/perror"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
@@ -13,22 +19,43 @@ import (
 
 // Nats ...
 type Nats struct {
-	kv *natsclient.KV
+	stream *natsclient.JetStream
+	kv     *natsclient.KV
+
+	mu sync.Mutex
+	// expiring holds the buckets of the keys set by StoreIfNotExists by their ttl, the keys are
+	// removed by NATS once they expire so that the buckets do not grow without limit
+	expiring map[time.Duration]*natsclient.KV
 }
 
 // NewNats returns NATS kv client
 func NewNats(ctx context.Context, conn *natsclient.Client) (IKV, error) {
-	stream, err := natsclient.NewJetStream(ctx, conn)
+	stream, err := natsclient.NewJetStream(ctx, conn, 0)
 	if err != nil {
 		pErr := perror.Newf(perror.Internal, "error creating nats jetstream %v", err)
 		logger.Ctx(ctx).Error(pErr.Error())
 		return nil, pErr
 	}
-	kv, err := stream.CreateKeyValue(ctx, "bucket")
+	kv, err := stream.CreateKeyValue(ctx, "bucket", 0)
+	if err != nil {
+		return nil, err
+	}
+	return &Nats{stream: stream, kv: kv, expiring: make(map[time.Duration]*natsclient.KV)}, nil
+}
+
+// expiringBucket returns the bucket of the keys which expire after ttl, creating it if needed
+func (n *Nats) expiringBucket(ctx context.Context, ttl time.Duration) (*natsclient.KV, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if kv, ok := n.expiring[ttl]; ok {
+		return kv, nil
+	}
+	kv, err := n.stream.CreateKeyValue(ctx, fmt.Sprintf("expiring_%d", ttl.Milliseconds()), ttl)
 	if err != nil {
 		return nil, err
 	}
-	return &Nats{kv}, nil
+	n.expiring[ttl] = kv
+	return kv, nil
 }
 
 // Store key with values
@@ -57,12 +84,15 @@ func (n *Nats) Store(ctx context.Context, key string, field string, attrs string
 		logger.Ctx(ctx).Error(pErr.Error())
 		return pErr
 	}
-	return n.kv.Put(ctx, key, buf.Bytes())
+	return n.kv.Put(ctx, encodeKey(key), buf.Bytes())
 }
 
 // Load values for a key
 func (n *Nats) Load(ctx context.Context, key string) (map[string]string, error) {
-	b, _ := n.kv.Get(ctx, key)
+	b, _ := n.kv.Get(ctx, encodeKey(key))
+	if len(b) == 0 {
+		return map[string]string{}, nil
+	}
 	buffer := bytes.NewBuffer(b)
 	var attrs map[string]string
 	decoder := gob.NewDecoder(buffer)
@@ -73,13 +103,26 @@ func (n *Nats) Load(ctx context.Context, key string) (map[string]string, error)
 	return attrs, nil
 }
 
+// LoadBulk loads values for the keys one by one, as NATS kv has no batched get
+func (n *Nats) LoadBulk(ctx context.Context, keys ...string) ([]map[string]string, error) {
+	values := make([]map[string]string, len(keys))
+	for i, key := range keys {
+		v, err := n.Load(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
 // Delete values for a key
 func (n *Nats) Delete(ctx context.Context, key string, fields ...string) error {
 	existingMap, _ := n.Load(ctx, key)
 	for _, field := range fields {
 		delete(existingMap, field)
 	}
-	err := n.kv.Delete(ctx, key)
+	err := n.kv.Delete(ctx, encodeKey(key))
 	if err != nil {
 		return err
 	}
@@ -91,3 +134,72 @@ func (n *Nats) Delete(ctx context.Context, key string, fields ...string) error {
 	}
 	return nil
 }
+
+// StoreIfNotExists sets a key which expires after ttl, returns false if the key exists.
+// NATS kv has no per key expiry, so the key is set in a bucket whose keys expire after ttl. As NATS removes
+// expired keys periodically, the expiry is also stored as the value and an expired key is taken over.
+func (n *Nats) StoreIfNotExists(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	kv, err := n.expiringBucket(ctx, ttl)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	expiresAt := []byte(strconv.FormatInt(now.Add(ttl).UnixNano(), 10))
+	created, err := kv.Create(ctx, encodeKey(key), expiresAt)
+	if err != nil || created {
+		return created, err
+	}
+	v, revision, err := kv.GetWithRevision(ctx, encodeKey(key))
+	if err != nil {
+		return false, err
+	}
+	existingExpiresAt, err := strconv.ParseInt(string(v), 10, 64)
+	if err == nil && now.UnixNano() < existingExpiresAt {
+		return false, nil
+	}
+	return kv.Update(ctx, encodeKey(key), expiresAt, revision)
+}
+
+// DeleteKey deletes a key, including a key set by StoreIfNotExists on this node
+func (n *Nats) DeleteKey(ctx context.Context, key string) error {
+	n.mu.Lock()
+	buckets := make([]*natsclient.KV, 0, len(n.expiring)+1)
+	for _, kv := range n.expiring {
+		buckets = append(buckets, kv)
+	}
+	n.mu.Unlock()
+	buckets = append(buckets, n.kv)
+	for _, kv := range buckets {
+		err := kv.Delete(ctx, encodeKey(key))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeKey maps a key having characters not allowed in NATS kv keys, e.g. the # of the keys of the
+// records of a client, to an allowed key. Allowed keys are kept as they are, so that keys stored
+// before are still found. Keys which are not allowed could never be stored, so no key is moved.
+func encodeKey(key string) string {
+	if isValidKey(key) {
+		return key
+	}
+	return "=" + base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// isValidKey checks if the key is allowed by NATS kv
+func isValidKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, ".") || strings.HasSuffix(key, ".") {
+		return false
+	}
+	for _, r := range key {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '/', r == '_', r == '=', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
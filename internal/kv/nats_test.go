@@ -0,0 +1,71 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	natsclient "github.com/CRED-CLUB/propeller/pkg/broker/nats"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestNats(t *testing.T) *Nats {
+	_, err := logger.NewLogger("dev", nil, nil)
+	assert.NoError(t, err)
+	ns, err := server.NewServer(&server.Options{JetStream: true, Port: -1, StoreDir: t.TempDir()})
+	assert.NoError(t, err)
+	go ns.Start()
+	t.Cleanup(ns.Shutdown)
+	assert.True(t, ns.ReadyForConnections(5*time.Second))
+
+	ctx := context.Background()
+	conn, err := natsclient.NewClient(ctx, natsclient.Config{URL: ns.ClientURL()})
+	assert.NoError(t, err)
+	kv, err := NewNats(ctx, conn)
+	assert.NoError(t, err)
+	return kv.(*Nats)
+}
+
+func TestEncodeKey(t *testing.T) {
+	// allowed keys, e.g. of device records, are kept as they are
+	assert.Equal(t, "client-1", encodeKey("client-1"))
+	assert.Equal(t, "orders.eu", encodeKey("orders.eu"))
+
+	for _, key := range []string{"client-1#connections", "orders.*#presence", ".orders", "a b"} {
+		encoded := encodeKey(key)
+		assert.True(t, isValidKey(encoded), key)
+		assert.NotEqual(t, encodeKey("client-1#connection"), encoded)
+	}
+}
+
+func TestNats_StoreIfNotExists(t *testing.T) {
+	ctx := context.Background()
+	n := newTestNats(t)
+	ttl := time.Second
+
+	ok, err := n.StoreIfNotExists(ctx, "client-1#key#idempotency", ttl)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = n.StoreIfNotExists(ctx, "client-1#key#idempotency", ttl)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// released keys can be set again
+	assert.NoError(t, n.DeleteKey(ctx, "client-1#key#idempotency"))
+	ok, err = n.StoreIfNotExists(ctx, "client-1#key#idempotency", ttl)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// expired keys are removed from the bucket
+	bucket, err := n.expiringBucket(ctx, ttl)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, err := bucket.Get(ctx, encodeKey("client-1#key#idempotency"))
+		return err != nil
+	}, 5*time.Second, 100*time.Millisecond)
+	ok, err = n.StoreIfNotExists(ctx, "client-1#key#idempotency", ttl)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
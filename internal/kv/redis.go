@@ -2,6 +2,7 @@ package kv
 
 import (
 	"context"
+	"time"
 
 	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
 )
@@ -30,7 +31,25 @@ func (r *Redis) Load(ctx context.Context, key string) (map[string]string, error)
 	return r.redisClient.HGetAll(ctx, key)
 }
 
+// LoadBulk loads values for the keys in a pipeline
+func (r *Redis) LoadBulk(ctx context.Context, keys ...string) ([]map[string]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return r.redisClient.HGetAllBulk(ctx, keys...)
+}
+
 // Delete values for a key
 func (r *Redis) Delete(ctx context.Context, key string, fields ...string) error {
 	return r.redisClient.Delete(ctx, key, fields...)
 }
+
+// StoreIfNotExists sets a key which expires after ttl, returns false if the key exists
+func (r *Redis) StoreIfNotExists(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return r.redisClient.SetNX(ctx, key, 1, ttl)
+}
+
+// DeleteKey deletes a key
+func (r *Redis) DeleteKey(ctx context.Context, key string) error {
+	return r.redisClient.Del(ctx, key)
+}
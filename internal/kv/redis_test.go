@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedis_StoreIfNotExists(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	ctx := context.Background()
+	kv := NewRedis(redispkg.NewClient(redispkg.Config{Address: mr.Addr()}))
+
+	ok, err := kv.StoreIfNotExists(ctx, "key", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// key exists within ttl
+	ok, err = kv.StoreIfNotExists(ctx, "key", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// key can be stored again once expired
+	mr.FastForward(2 * time.Minute)
+	ok, err = kv.StoreIfNotExists(ctx, "key", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// key can be stored again once deleted
+	assert.NoError(t, kv.DeleteKey(ctx, "key"))
+	ok, err = kv.StoreIfNotExists(ctx, "key", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestRedis_LoadBulk(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	ctx := context.Background()
+	kv := NewRedis(redispkg.NewClient(redispkg.Config{Address: mr.Addr()}))
+
+	assert.NoError(t, kv.Store(ctx, "key1", "field1", "value1"))
+	assert.NoError(t, kv.Store(ctx, "key3", "field3", "value3"))
+
+	values, err := kv.LoadBulk(ctx, "key1", "key2", "key3")
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"field1": "value1"}, {}, {"field3": "value3"}}, values)
+}
@@ -0,0 +1,382 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
+	"github.com/CRED-CLUB/propeller/internal/sendqueue"
+	"github.com/CRED-CLUB/propeller/pkg/broker"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// subjectSubscriber subscribes the node to subjects of a broker, the events received for
+// a subject are passed to deliver till the subject is unsubscribed
+type subjectSubscriber interface {
+	subscribe(ctx context.Context, subject string, deliver func(broker.TopicEvent)) error
+	unsubscribe(ctx context.Context, subject string) error
+}
+
+// fanOut holds at most one broker subscription per subject on the node and fans the events
+// received for a subject out to all local subscriptions of the subject. The broker subscription
+// is created by the first local subscription of the subject and removed with the last one.
+//
+// Events are handed to a queue of each local subscription, so that a local subscription which
+// does not take its events does not hold up the other local subscriptions of the subject.
+type fanOut struct {
+	subscriber  subjectSubscriber
+	queueConfig sendqueue.Config
+
+	mu       sync.Mutex
+	subjects map[string]*sharedSubject
+	locals   map[uuid.UUID]*localSubscription
+}
+
+// sharedSubject is a subject subscribed on the broker with its local subscriptions
+type sharedSubject struct {
+	subject string
+	// subscribeMu serializes subscribing and unsubscribing the subject on the broker
+	subscribeMu sync.Mutex
+	subscribed  bool
+	// removed is set once the subject is unsubscribed, it is then subscribed again as a new sharedSubject
+	removed bool
+
+	localsMu sync.RWMutex
+	locals   map[uuid.UUID]*localSubscription
+}
+
+// localSubscription is a subscription of this node which receives the events of its subjects
+type localSubscription struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	subscription *subscription.Subscription
+	subjects     map[string]struct{}
+	closed       bool
+	// in is the input of the queue of the events to be taken by the subscription
+	in    chan broker.TopicEvent
+	queue *sendqueue.Queue
+}
+
+func newFanOut(subscriber subjectSubscriber, queueConfig sendqueue.Config) *fanOut {
+	return &fanOut{
+		subscriber:  subscriber,
+		queueConfig: queueConfig,
+		subjects:    make(map[string]*sharedSubject),
+		locals:      make(map[uuid.UUID]*localSubscription),
+	}
+}
+
+// subscribe returns a new local subscription to the subjects, events are delivered till ctx is done
+func (f *fanOut) subscribe(ctx context.Context, subject ...string) (*subscription.Subscription, error) {
+	subs, err := newSubscription()
+	if err != nil {
+		logger.Ctx(ctx).Error(err.Error())
+		return nil, err
+	}
+	localCtx, cancel := context.WithCancel(ctx)
+	local := &localSubscription{
+		ctx:          localCtx,
+		cancel:       cancel,
+		subscription: subs,
+		subjects:     make(map[string]struct{}),
+		in:           make(chan broker.TopicEvent),
+		queue:        sendqueue.NewQueue(f.queueConfig),
+	}
+	subs.Overflow = local.queue.Overflow()
+	local.queue.Start(localCtx, local.in)
+	go local.forward()
+
+	f.mu.Lock()
+	f.locals[subs.ID] = local
+	f.mu.Unlock()
+	localSubscriptions.Inc()
+
+	for _, s := range subject {
+		err = f.add(ctx, s, subs)
+		if err != nil {
+			_ = f.unsubscribe(ctx, subs)
+			return nil, err
+		}
+	}
+	return subs, nil
+}
+
+// add subscribes the local subscription to the subject, the subject is subscribed on the broker
+// if this is its first local subscription
+func (f *fanOut) add(ctx context.Context, subject string, subs *subscription.Subscription) error {
+	f.mu.Lock()
+	local, ok := f.locals[subs.ID]
+	if !ok {
+		f.mu.Unlock()
+		pErr := perror.Newf(perror.NotFound, "unable to get the subscription %s", subs.ID)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	if _, ok = local.subjects[subject]; ok {
+		f.mu.Unlock()
+		return nil
+	}
+	local.subjects[subject] = struct{}{}
+	f.mu.Unlock()
+
+	err := f.acquire(ctx, subject, subs.ID, local)
+	if err != nil {
+		f.mu.Lock()
+		delete(local.subjects, subject)
+		f.mu.Unlock()
+		return err
+	}
+
+	// the local subscription was unsubscribed while the subject was being subscribed
+	f.mu.Lock()
+	closed := local.closed
+	f.mu.Unlock()
+	if closed {
+		return f.release(ctx, subject, subs.ID)
+	}
+	return nil
+}
+
+// forward events of a broker subscription of the local subscription alone, e.g. resumed from a cursor
+func (f *fanOut) forward(ctx context.Context, ch chan broker.TopicEvent, subs *subscription.Subscription) {
+	f.mu.Lock()
+	local, ok := f.locals[subs.ID]
+	f.mu.Unlock()
+	if !ok {
+		return
+	}
+	for {
+		select {
+		case te := <-ch:
+			local.deliver(te)
+		case <-ctx.Done():
+			logger.Ctx(ctx).Debug("stopping subscriber loop")
+			return
+		case <-local.ctx.Done():
+			return
+		}
+	}
+}
+
+// remove unsubscribes the local subscription from the subject, the subject is unsubscribed on
+// the broker if this was its last local subscription
+func (f *fanOut) remove(ctx context.Context, subject string, subs *subscription.Subscription) error {
+	f.mu.Lock()
+	local, ok := f.locals[subs.ID]
+	if ok {
+		_, ok = local.subjects[subject]
+		delete(local.subjects, subject)
+	}
+	f.mu.Unlock()
+	if !ok {
+		pErr := perror.Newf(perror.NotFound, "subscription %s is not subscribed to %s", subs.ID, subject)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return f.release(ctx, subject, subs.ID)
+}
+
+// unsubscribe removes the local subscription from all its subjects
+func (f *fanOut) unsubscribe(ctx context.Context, subs *subscription.Subscription) error {
+	f.mu.Lock()
+	local, ok := f.locals[subs.ID]
+	if !ok {
+		f.mu.Unlock()
+		pErr := perror.Newf(perror.NotFound, "unable to get the subscription %s", subs.ID)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	delete(f.locals, subs.ID)
+	local.closed = true
+	subjects := local.subjects
+	local.subjects = make(map[string]struct{})
+	f.mu.Unlock()
+	local.cancel()
+	localSubscriptions.Dec()
+
+	var err error
+	for subject := range subjects {
+		rErr := f.release(ctx, subject, subs.ID)
+		if rErr != nil {
+			err = rErr
+		}
+	}
+	return err
+}
+
+// acquire adds the local subscription to the subject, subscribing it on the broker if needed
+func (f *fanOut) acquire(ctx context.Context, subject string, id uuid.UUID, local *localSubscription) error {
+	for {
+		f.mu.Lock()
+		s, ok := f.subjects[subject]
+		if !ok {
+			s = &sharedSubject{subject: subject, locals: make(map[uuid.UUID]*localSubscription)}
+			f.subjects[subject] = s
+		}
+		f.mu.Unlock()
+
+		s.subscribeMu.Lock()
+		if s.removed {
+			// unsubscribed concurrently by its last local subscription
+			s.subscribeMu.Unlock()
+			continue
+		}
+		if !s.subscribed {
+			err := f.subscriber.subscribe(context.WithoutCancel(ctx), subject, s.deliver)
+			if err != nil {
+				f.removeSubject(s)
+				s.subscribeMu.Unlock()
+				return err
+			}
+			s.subscribed = true
+			brokerSubscriptions.Inc()
+		}
+		s.localsMu.Lock()
+		s.locals[id] = local
+		s.localsMu.Unlock()
+		s.subscribeMu.Unlock()
+		return nil
+	}
+}
+
+// release removes the local subscription from the subject, unsubscribing it on the broker
+// if this was its last local subscription
+func (f *fanOut) release(ctx context.Context, subject string, id uuid.UUID) error {
+	f.mu.Lock()
+	s, ok := f.subjects[subject]
+	f.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	s.subscribeMu.Lock()
+	defer s.subscribeMu.Unlock()
+	s.localsMu.Lock()
+	_, ok = s.locals[id]
+	delete(s.locals, id)
+	remaining := len(s.locals)
+	s.localsMu.Unlock()
+	if !ok || remaining > 0 || s.removed {
+		return nil
+	}
+	// the subject is removed only once unsubscribed, so that it is not subscribed again before
+	err := f.subscriber.unsubscribe(context.WithoutCancel(ctx), subject)
+	f.removeSubject(s)
+	brokerSubscriptions.Dec()
+	return err
+}
+
+// removeSubject is called with subscribeMu of the subject held
+func (f *fanOut) removeSubject(s *sharedSubject) {
+	s.removed = true
+	f.mu.Lock()
+	if f.subjects[s.subject] == s {
+		delete(f.subjects, s.subject)
+	}
+	f.mu.Unlock()
+}
+
+// deliver sends the event to all local subscriptions of the subject
+func (s *sharedSubject) deliver(te broker.TopicEvent) {
+	s.localsMu.RLock()
+	locals := make([]*localSubscription, 0, len(s.locals))
+	for _, local := range s.locals {
+		locals = append(locals, local)
+	}
+	s.localsMu.RUnlock()
+
+	for _, local := range locals {
+		local.deliver(te)
+	}
+}
+
+// deliver hands the event to the queue of the subscription, which takes it as soon as it is received
+func (l *localSubscription) deliver(te broker.TopicEvent) {
+	select {
+	case l.in <- te:
+		eventsFannedOut.Inc()
+	case <-l.queue.Overflow():
+	case <-l.ctx.Done():
+	}
+}
+
+// forward sends the queued events to the subscription till it is unsubscribed
+func (l *localSubscription) forward() {
+	for {
+		select {
+		case te := <-l.queue.Out():
+			select {
+			case l.subscription.TopicEventChan <- te:
+			case <-l.ctx.Done():
+				return
+			}
+		case <-l.ctx.Done():
+			return
+		}
+	}
+}
+
+// subscriptionPerSubject subscribes the node to each subject with a broker subscription of its own
+type subscriptionPerSubject struct {
+	subscribeFunc   func(ctx context.Context, subject string) (broker.ISubscription, error)
+	unsubscribeFunc func(ctx context.Context, s broker.ISubscription) error
+
+	mu            sync.Mutex
+	subscriptions map[string]subjectSubscription
+}
+
+type subjectSubscription struct {
+	subscription broker.ISubscription
+	cancel       context.CancelFunc
+}
+
+func newSubscriptionPerSubject(
+	subscribeFunc func(ctx context.Context, subject string) (broker.ISubscription, error),
+	unsubscribeFunc func(ctx context.Context, s broker.ISubscription) error,
+) *subscriptionPerSubject {
+	return &subscriptionPerSubject{
+		subscribeFunc:   subscribeFunc,
+		unsubscribeFunc: unsubscribeFunc,
+		subscriptions:   make(map[string]subjectSubscription),
+	}
+}
+
+func (p *subscriptionPerSubject) subscribe(ctx context.Context, subject string, deliver func(broker.TopicEvent)) error {
+	subCtx, cancel := context.WithCancel(ctx)
+	s, err := p.subscribeFunc(subCtx, subject)
+	if err != nil {
+		cancel()
+		return err
+	}
+	p.mu.Lock()
+	p.subscriptions[subject] = subjectSubscription{subscription: s, cancel: cancel}
+	p.mu.Unlock()
+
+	go func() {
+		ch := s.GetTopicEventChan()
+		for {
+			select {
+			case te := <-ch:
+				deliver(te)
+			case <-subCtx.Done():
+				logger.Ctx(subCtx).Debugw("stopping subject subscription", "subject", subject)
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (p *subscriptionPerSubject) unsubscribe(ctx context.Context, subject string) error {
+	p.mu.Lock()
+	s, ok := p.subscriptions[subject]
+	delete(p.subscriptions, subject)
+	p.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	defer s.cancel()
+	return p.unsubscribeFunc(ctx, s.subscription)
+}
@@ -0,0 +1,23 @@
+package pubsub
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	brokerSubscriptions = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "propeller_pubsub_broker_subscriptions",
+		Help: "The number of subjects subscribed on the broker by this node",
+	})
+
+	localSubscriptions = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "propeller_pubsub_local_subscriptions",
+		Help: "The number of local subscriptions the events of the broker subscriptions are fanned out to",
+	})
+
+	eventsFannedOut = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_pubsub_events_fanned_out_total",
+		Help: "Total number of events delivered to local subscriptions",
+	})
+)
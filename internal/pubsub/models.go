@@ -4,4 +4,6 @@ package pubsub
 type PublishRequest struct {
 	Channel string
 	Data    []byte
+	// ID deduplicates the event in brokers which support it, optional
+	ID string
 }
@@ -9,24 +9,30 @@ import (
 
 	"github.com/CRED-CLUB/propeller/internal/perror"
 	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
+	"github.com/CRED-CLUB/propeller/internal/sendqueue"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
-	"github.com/google/uuid"
 )
 
-// Nats is wrapper for nats pubsub
+// Nats is wrapper for nats pubsub, the node holds a nats subscription per subject
+// which is fanned out to the local subscriptions of the subject
 type Nats struct {
 	BasePubSub
 	natsClient natspkg.INats
+	fanOut     *fanOut
 }
 
 // NewNats returns nats
-func NewNats(client natspkg.INats) IPubSub {
-	return &Nats{BasePubSub{&sync.Map{}}, client}
+func NewNats(client natspkg.INats, queueConfig sendqueue.Config) IPubSub {
+	return &Nats{
+		BasePubSub: BasePubSub{&sync.Map{}},
+		natsClient: client,
+		fanOut:     newFanOut(newSubscriptionPerSubject(client.Subscribe, client.UnSubscribe), queueConfig),
+	}
 }
 
 // Publish a event
 func (n *Nats) Publish(ctx context.Context, request PublishRequest) error {
-	publishReq := natspkg.PublishRequest{Channel: request.Channel, Data: request.Data}
+	publishReq := natspkg.PublishRequest{Channel: request.Channel, Data: request.Data, ID: request.ID}
 	return n.natsClient.Publish(ctx, publishReq)
 }
 
@@ -34,7 +40,7 @@ func (n *Nats) Publish(ctx context.Context, request PublishRequest) error {
 func (n *Nats) PublishBulk(ctx context.Context, request []PublishRequest) error {
 	//TODO implement bulk in NATS @Mayank
 	for _, v := range request {
-		publishReq := natspkg.PublishRequest{Channel: v.Channel, Data: v.Data}
+		publishReq := natspkg.PublishRequest{Channel: v.Channel, Data: v.Data, ID: v.ID}
 		err := n.natsClient.Publish(ctx, publishReq)
 		if err != nil {
 			pErr := perror.Newf(perror.Internal, "error in publishing %w", err)
@@ -47,103 +53,44 @@ func (n *Nats) PublishBulk(ctx context.Context, request []PublishRequest) error
 
 // AsyncSubscribe to subscribe to a subject
 func (n *Nats) AsyncSubscribe(ctx context.Context, subject ...string) (*subscription.Subscription, error) {
-	id, err := uuid.NewUUID()
+	return n.fanOut.subscribe(ctx, subject...)
+}
+
+// AsyncSubscribeFrom subscribes to a subject and receives events after the cursor. The subject
+// is subscribed for the subscription alone, as the events received depend on its cursor.
+func (n *Nats) AsyncSubscribeFrom(ctx context.Context, subject string, cursor string) (*subscription.Subscription, error) {
+	subs, err := n.fanOut.subscribe(ctx)
 	if err != nil {
 		return nil, err
 	}
-	subs := &subscription.Subscription{
-		TopicEventChan: make(chan broker.TopicEvent),
-		ErrChan:        make(chan error),
-		ID:             id,
-	}
-
-	var natsSubscriptionList []broker.ISubscription
-
-	for _, s := range subject {
-		ns, err := n.natsClient.Subscribe(ctx, s)
-		if err != nil {
-			subs.ErrChan <- err
-			return nil, err
-		}
-		ch := ns.GetTopicEventChan()
-		go func() {
-			for {
-				select {
-				case p := <-ch:
-					subs.TopicEventChan <- p
-				case <-ctx.Done():
-					logger.Ctx(ctx).Debug("stopping subscriber loop")
-					return
-				}
-			}
-		}()
-		natsSubscriptionList = append(natsSubscriptionList, ns)
-		n.BasePubSub.Store(ctx, id.String(), natsSubscriptionList)
+	ns, err := n.natsClient.SubscribeFrom(ctx, subject, cursor)
+	if err != nil {
+		_ = n.fanOut.unsubscribe(ctx, subs)
+		return nil, err
 	}
+	go n.fanOut.forward(ctx, ns.GetTopicEventChan(), subs)
+	n.BasePubSub.Store(ctx, subs.ID.String(), ns)
 	return subs, nil
 }
 
-// Unsubscribe from a subject
+// Unsubscribe from all subjects of the subscription
 func (n *Nats) Unsubscribe(ctx context.Context, subs *subscription.Subscription) error {
-	v, err := n.BasePubSub.LoadAndDelete(ctx, subs.ID.String())
-	if err != nil {
-		return err
+	err := n.fanOut.unsubscribe(ctx, subs)
+	if v, ok := n.ChannelSubscriptionMap.LoadAndDelete(subs.ID.String()); ok {
+		uErr := n.natsClient.UnSubscribe(ctx, v.(broker.ISubscription))
+		if uErr != nil {
+			return uErr
+		}
 	}
-	return n.removeSubscription(ctx, v)
+	return err
 }
 
-// AddSubscription ..
+// AddSubscription subscribes the subscription to a subject
 func (n *Nats) AddSubscription(ctx context.Context, subject string, subs *subscription.Subscription) error {
-	ns, err := n.natsClient.Subscribe(ctx, subject)
-	if err != nil {
-		subs.ErrChan <- err
-		return err
-	}
-	ch := ns.GetTopicEventChan()
-	go func() {
-		for {
-			select {
-			case p := <-ch:
-				subs.TopicEventChan <- p
-			case <-ctx.Done():
-				logger.Ctx(ctx).Debug("stopping subscriber loop")
-				return
-			}
-		}
-	}()
-	n.BasePubSub.Store(ctx, subject, ns)
-	return nil
+	return n.fanOut.add(ctx, subject, subs)
 }
 
-// RemoveSubscription ...
+// RemoveSubscription unsubscribes the subscription from a subject
 func (n *Nats) RemoveSubscription(ctx context.Context, subject string, subs *subscription.Subscription) error {
-	v, err := n.BasePubSub.LoadAndDelete(ctx, subject)
-	if err != nil {
-		return err
-	}
-	return n.removeSubscription(ctx, v)
-}
-
-func (n *Nats) removeSubscription(ctx context.Context, v interface{}) error {
-	switch t := v.(type) {
-	case broker.ISubscription:
-		return n.natsClient.UnSubscribe(ctx, t)
-	case []broker.ISubscription:
-		for _, sub := range t {
-			err := n.natsClient.UnSubscribe(ctx, sub)
-			if err != nil {
-				return err
-			}
-		}
-		return nil
-	default:
-		pErr := perror.Newf(perror.Internal, "type not defined %s", t)
-		logger.Ctx(ctx).Error(pErr)
-		return pErr
-	}
-}
-
-type natsSubscription struct {
-	ctx          context.Context
-	subscription *subscription.Subscription
+	return n.fanOut.remove(ctx, subject, subs)
 }
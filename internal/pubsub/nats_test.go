@@ -4,7 +4,10 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
+	"github.com/CRED-CLUB/propeller/internal/sendqueue"
 	"github.com/CRED-CLUB/propeller/pkg/broker"
 	natspkg "github.com/CRED-CLUB/propeller/pkg/broker/nats"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
@@ -26,6 +29,11 @@ func (m *mockNatsClient) Subscribe(ctx context.Context, subject string) (broker.
 	return args.Get(0).(broker.ISubscription), args.Error(1)
 }
 
+func (m *mockNatsClient) SubscribeFrom(ctx context.Context, subject string, cursor string) (broker.ISubscription, error) {
+	args := m.Called(ctx, subject, cursor)
+	return args.Get(0).(broker.ISubscription), args.Error(1)
+}
+
 func (m *mockNatsClient) UnSubscribe(ctx context.Context, subscription broker.ISubscription) error {
 	args := m.Called(ctx, subscription)
 	return args.Error(0)
@@ -47,14 +55,14 @@ func (m *mockSubscription) GetTopics() []string {
 
 func TestNewNats(t *testing.T) {
 	mockClient := &mockNatsClient{}
-	nats := NewNats(mockClient)
+	nats := NewNats(mockClient, sendqueue.Config{})
 	assert.NotNil(t, nats)
 }
 
 func TestNats_Publish(t *testing.T) {
 	ctx := context.Background()
 	mockClient := &mockNatsClient{}
-	nats := NewNats(mockClient).(*Nats)
+	nats := NewNats(mockClient, sendqueue.Config{}).(*Nats)
 
 	req := PublishRequest{
 		Channel: "test-channel",
@@ -74,7 +82,7 @@ func TestNats_Publish(t *testing.T) {
 func TestNats_PublishBulk(t *testing.T) {
 	ctx := context.Background()
 	mockClient := &mockNatsClient{}
-	nats := NewNats(mockClient).(*Nats)
+	nats := NewNats(mockClient, sendqueue.Config{}).(*Nats)
 
 	requests := []PublishRequest{
 		{Channel: "channel1", Data: []byte("data1")},
@@ -97,97 +105,178 @@ func TestNats_AsyncSubscribe(t *testing.T) {
 	ctx := context.Background()
 	mockClient := &mockNatsClient{}
 	mockSub := &mockSubscription{}
-	nats := NewNats(mockClient).(*Nats)
+	nats := NewNats(mockClient, sendqueue.Config{}).(*Nats)
 
 	eventChan := make(chan broker.TopicEvent)
 	mockSub.On("GetTopicEventChan").Return(eventChan)
-	mockClient.On("Subscribe", ctx, "test-subject").Return(mockSub, nil)
+	mockClient.On("Subscribe", mock.Anything, "test-subject").Return(mockSub, nil)
 
 	sub, err := nats.AsyncSubscribe(ctx, "test-subject")
 	assert.NoError(t, err)
 	assert.NotNil(t, sub)
 	mockClient.AssertExpectations(t)
-	mockSub.AssertExpectations(t)
 }
 
-func TestNats_Unsubscribe(t *testing.T) {
+func TestNats_AsyncSubscribeFrom(t *testing.T) {
 	ctx := context.Background()
 	mockClient := &mockNatsClient{}
 	mockSub := &mockSubscription{}
-	nats := NewNats(mockClient).(*Nats)
+	nats := NewNats(mockClient, sendqueue.Config{}).(*Nats)
 
-	nats.BasePubSub = BasePubSub{ChannelSubscriptionMap: &sync.Map{}}
-	nats.BasePubSub.Store(ctx, "test-id", mockSub)
-
-	mockClient.On("UnSubscribe", ctx, mockSub).Return(nil)
+	eventChan := make(chan broker.TopicEvent)
+	mockSub.On("GetTopicEventChan").Return(eventChan)
+	mockClient.On("SubscribeFrom", ctx, "test-subject", "42").Return(mockSub, nil)
 
-	err := nats.removeSubscription(ctx, mockSub)
+	sub, err := nats.AsyncSubscribeFrom(ctx, "test-subject", "42")
 	assert.NoError(t, err)
+	assert.NotNil(t, sub)
 	mockClient.AssertExpectations(t)
+	mockSub.AssertExpectations(t)
 }
 
-func TestNats_RemoveSubscription(t *testing.T) {
-	// Initialize logger for tests
+func initTestLogger(t *testing.T) {
 	serviceKV := map[string]interface{}{
 		"serviceName":   "test-service",
 		"gitCommitHash": "test-hash",
 	}
 	_, err := logger.NewLogger("dev", serviceKV, nil)
 	assert.NoError(t, err)
+}
 
-	ctx := context.Background()
+// receive returns the event received by each subscription, the subscriptions are read
+// concurrently as an event is delivered to them one after another
+func receive(t *testing.T, subs ...*subscription.Subscription) []broker.TopicEvent {
+	t.Helper()
+	events := make([]broker.TopicEvent, len(subs))
+	var wg sync.WaitGroup
+	for i, sub := range subs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case events[i] = <-sub.TopicEventChan:
+			case <-time.After(time.Second):
+			}
+		}()
+	}
+	wg.Wait()
+	for i, te := range events {
+		assert.NotNil(t, te.Event, "event not received by subscription %d", i)
+	}
+	return events
+}
+
+// newTestSubscription returns a broker subscription, mocks are not used as they are read concurrently
+func newTestSubscription() *broker.BaseSubscription {
+	return &broker.BaseSubscription{TopicEventChan: make(chan broker.TopicEvent)}
+}
+
+func TestNats_SharedSubscription(t *testing.T) {
+	initTestLogger(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	mockClient := &mockNatsClient{}
-	mockSub := &mockSubscription{}
-	nats := NewNats(mockClient).(*Nats)
+	nats := NewNats(mockClient, sendqueue.Config{}).(*Nats)
 
-	t.Run("successful removal", func(t *testing.T) {
-		// Setup
-		subject := "test-subject"
-		nats.BasePubSub = BasePubSub{ChannelSubscriptionMap: &sync.Map{}}
-		nats.BasePubSub.Store(ctx, subject, mockSub)
+	eventChan := make(chan broker.TopicEvent)
+	mockSub := &broker.BaseSubscription{TopicEventChan: eventChan, Topics: []string{"broadcast"}}
+	// a single nats subscription for all subscriptions of the node
+	mockClient.On("Subscribe", mock.Anything, "broadcast").Return(mockSub, nil).Once()
 
-		// Set expectations
-		mockClient.On("UnSubscribe", ctx, mockSub).Return(nil).Once()
+	sub1, err := nats.AsyncSubscribe(ctx, "broadcast")
+	assert.NoError(t, err)
+	sub2, err := nats.AsyncSubscribe(ctx, "broadcast")
+	assert.NoError(t, err)
+	// subscribing again to the same subject is a no-op
+	assert.NoError(t, nats.AddSubscription(ctx, "broadcast", sub1))
+
+	go func() { eventChan <- broker.TopicEvent{Topic: "broadcast", Event: []byte("first")} }()
+	events := receive(t, sub1, sub2)
+	assert.Equal(t, []byte("first"), events[0].Event)
+	assert.Equal(t, []byte("first"), events[1].Event)
+
+	// the nats subscription is held till the last subscription of the subject is unsubscribed
+	assert.NoError(t, nats.Unsubscribe(ctx, sub1))
+	mockClient.AssertNotCalled(t, "UnSubscribe", mock.Anything, mockSub)
+	go func() { eventChan <- broker.TopicEvent{Topic: "broadcast", Event: []byte("second")} }()
+	assert.Equal(t, []byte("second"), receive(t, sub2)[0].Event)
+
+	mockClient.On("UnSubscribe", mock.Anything, mockSub).Return(nil).Once()
+	assert.NoError(t, nats.Unsubscribe(ctx, sub2))
+	mockClient.AssertExpectations(t)
 
-		// Execute
-		err := nats.RemoveSubscription(ctx, subject, nil)
+	// unsubscribing again fails
+	assert.Error(t, nats.Unsubscribe(ctx, sub2))
+}
 
-		// Assert
-		assert.NoError(t, err)
-		mockClient.AssertExpectations(t)
+func TestNats_SlowSubscription(t *testing.T) {
+	initTestLogger(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockClient := &mockNatsClient{}
+	nats := NewNats(mockClient, sendqueue.Config{Size: 2, Policy: sendqueue.PolicyDisconnect}).(*Nats)
 
-		// Verify subscription was removed
-		_, err = nats.BasePubSub.Load(ctx, subject)
-		assert.Error(t, err)
-	})
+	eventChan := make(chan broker.TopicEvent)
+	mockClient.On("Subscribe", mock.Anything, "broadcast").Return(&broker.BaseSubscription{TopicEventChan: eventChan}, nil)
 
-	t.Run("subscription not found", func(t *testing.T) {
-		// Setup
-		subject := "non-existent-subject"
-		nats.BasePubSub = BasePubSub{ChannelSubscriptionMap: &sync.Map{}}
+	slow, err := nats.AsyncSubscribe(ctx, "broadcast")
+	assert.NoError(t, err)
+	fast, err := nats.AsyncSubscribe(ctx, "broadcast")
+	assert.NoError(t, err)
 
-		// Execute
-		err := nats.RemoveSubscription(ctx, subject, nil)
+	// slow never takes its events, fast receives all of them
+	for i := 0; i < 5; i++ {
+		event := []byte{byte(i)}
+		go func() { eventChan <- broker.TopicEvent{Topic: "broadcast", Event: event} }()
+		assert.Equal(t, event, receive(t, fast)[0].Event)
+	}
+	select {
+	case <-slow.Overflow:
+	case <-time.After(time.Second):
+		t.Fatal("queue of the slow subscription did not overflow")
+	}
+	select {
+	case <-fast.Overflow:
+		t.Fatal("queue of the fast subscription overflowed")
+	default:
+	}
+}
 
-		// Assert
-		assert.Error(t, err)
-		mockClient.AssertNotCalled(t, "UnSubscribe")
-	})
+func TestNats_RemoveSubscription(t *testing.T) {
+	initTestLogger(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockClient := &mockNatsClient{}
+	nats := NewNats(mockClient, sendqueue.Config{}).(*Nats)
 
-	t.Run("unsubscribe error", func(t *testing.T) {
-		// Setup
-		subject := "test-subject"
-		nats.BasePubSub = BasePubSub{ChannelSubscriptionMap: &sync.Map{}}
-		nats.BasePubSub.Store(ctx, subject, mockSub)
+	clientSub := newTestSubscription()
+	mockClient.On("Subscribe", mock.Anything, "client").Return(clientSub, nil)
+	sub, err := nats.AsyncSubscribe(ctx, "client")
+	assert.NoError(t, err)
 
-		// Set expectations
-		mockClient.On("UnSubscribe", ctx, mockSub).Return(assert.AnError).Once()
+	t.Run("successful removal", func(t *testing.T) {
+		topicSub := newTestSubscription()
+		mockClient.On("Subscribe", mock.Anything, "test-subject").Return(topicSub, nil).Once()
+		mockClient.On("UnSubscribe", mock.Anything, topicSub).Return(nil).Once()
 
-		// Execute
-		err := nats.RemoveSubscription(ctx, subject, nil)
+		assert.NoError(t, nats.AddSubscription(ctx, "test-subject", sub))
+		assert.NoError(t, nats.RemoveSubscription(ctx, "test-subject", sub))
+		mockClient.AssertExpectations(t)
+	})
 
-		// Assert
+	t.Run("subscription not found", func(t *testing.T) {
+		err := nats.RemoveSubscription(ctx, "non-existent-subject", sub)
 		assert.Error(t, err)
+		mockClient.AssertNotCalled(t, "UnSubscribe", mock.Anything, clientSub)
+	})
+
+	t.Run("unsubscribe error", func(t *testing.T) {
+		topicSub := newTestSubscription()
+		mockClient.On("Subscribe", mock.Anything, "test-subject").Return(topicSub, nil).Once()
+		mockClient.On("UnSubscribe", mock.Anything, topicSub).Return(assert.AnError).Once()
+
+		assert.NoError(t, nats.AddSubscription(ctx, "test-subject", sub))
+		err := nats.RemoveSubscription(ctx, "test-subject", sub)
 		assert.Equal(t, assert.AnError, err)
 		mockClient.AssertExpectations(t)
 	})
@@ -3,13 +3,17 @@ package pubsub
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/CRED-CLUB/propeller/internal/broker"
 	"github.com/CRED-CLUB/propeller/internal/perror"
 	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
+	"github.com/CRED-CLUB/propeller/internal/sendqueue"
+	brokerpkg "github.com/CRED-CLUB/propeller/pkg/broker"
 	natspkg "github.com/CRED-CLUB/propeller/pkg/broker/nats"
 	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
+	"github.com/google/uuid"
 )
 
 // IPubSub is pubsub interface
@@ -17,51 +21,52 @@ type IPubSub interface {
 	Publish(ctx context.Context, publishRequest PublishRequest) error
 	PublishBulk(ctx context.Context, publishRequest []PublishRequest) error
 	AsyncSubscribe(ctx context.Context, subject ...string) (*subscription.Subscription, error)
+	AsyncSubscribeFrom(ctx context.Context, subject string, cursor string) (*subscription.Subscription, error)
 	Unsubscribe(ctx context.Context, subs *subscription.Subscription) error
 	AddSubscription(ctx context.Context, subject string, subs *subscription.Subscription) error
 	RemoveSubscription(ctx context.Context, subject string, subs *subscription.Subscription) error
 }
 
-// New returns a new pubsub type
-func New(ctx context.Context, config broker.Config) (IPubSub, error) {
+// New returns a new pubsub type, the events of each subscription are queued as per queueConfig. The second
+// pubsub does not persist events, so that an event is received by every subscription of its subject even with
+// persistence enabled, e.g. control events. Its subscriptions are disconnected rather than drop events.
+func New(ctx context.Context, config broker.Config, queueConfig sendqueue.Config) (IPubSub, IPubSub, error) {
+	retention := time.Duration(config.RetentionInSec) * time.Second
+	queueConfig = queueConfig.HandOff(config.Persistence)
+	controlQueueConfig := sendqueue.Config{Policy: sendqueue.PolicyDisconnect}
 	switch config.Broker {
 	case "nats":
-		var psType natspkg.INats
 		natsClient, err := broker.NewNATSClient(ctx, config)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		switch config.Persistence {
-		case true:
-			psType, err = natspkg.NewJetStream(ctx, natsClient)
-			if err != nil {
-				return nil, err
-			}
-			logger.Ctx(ctx).Info("initialising NATS jetstream")
-		case false:
+		controlPubSub := NewNats(natspkg.NewPubSub(natsClient), controlQueueConfig)
+		if !config.Persistence {
 			logger.Ctx(ctx).Info("initialising NATS pubsub")
-			psType = natspkg.NewPubSub(natsClient)
+			return NewNats(natspkg.NewPubSub(natsClient), queueConfig), controlPubSub, nil
 		}
-		return NewNats(psType), nil
+		js, err := natspkg.NewJetStream(ctx, natsClient, retention)
+		if err != nil {
+			return nil, nil, err
+		}
+		logger.Ctx(ctx).Info("initialising NATS jetstream")
+		return NewNats(js, queueConfig), controlPubSub, nil
 	case "redis":
-		var psType redispkg.IRedis
 		redisClient, err := broker.NewRedisClient(ctx, config)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		switch config.Persistence {
-		case true:
-			logger.Ctx(ctx).Info("initialising redis streams")
-			psType = redispkg.NewStreams(redisClient)
-		case false:
+		controlPubSub := NewRedis(redispkg.NewPubSub(redisClient), controlQueueConfig)
+		if !config.Persistence {
 			logger.Ctx(ctx).Info("initialising redis pubsub")
-			psType = redispkg.NewPubSub(redisClient)
+			return NewRedis(redispkg.NewPubSub(redisClient), queueConfig), controlPubSub, nil
 		}
-		return NewRedis(psType), nil
+		logger.Ctx(ctx).Info("initialising redis streams")
+		return NewRedis(redispkg.NewStreams(redisClient, config.Redis.StreamMaxLen, retention), queueConfig), controlPubSub, nil
 	}
 	pErr := perror.Newf(perror.Internal, "unknown pubsub type")
 	logger.Ctx(ctx).Error(pErr.Error())
-	return nil, pErr
+	return nil, nil, pErr
 }
 
 // BasePubSub ...
@@ -95,3 +100,16 @@ func (b BasePubSub) Load(ctx context.Context, id string) (interface{}, error) {
 	}
 	return v, nil
 }
+
+func newSubscription() (*subscription.Subscription, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error in generating uuid %v", err)
+		return nil, pErr
+	}
+	return &subscription.Subscription{
+		TopicEventChan: make(chan brokerpkg.TopicEvent),
+		ErrChan:        make(chan error),
+		ID:             id,
+	}, nil
+}
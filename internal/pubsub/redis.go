@@ -6,21 +6,34 @@ import (
 
 	"github.com/CRED-CLUB/propeller/internal/perror"
 	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
+	"github.com/CRED-CLUB/propeller/internal/sendqueue"
 	"github.com/CRED-CLUB/propeller/pkg/broker"
 	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
-	"github.com/google/uuid"
 )
 
-// Redis is wrapper over redis pubSub
+// Redis is wrapper over redis pubSub, the node holds a single subscription per subject
+// which is fanned out to the local subscriptions of the subject
 type Redis struct {
 	BasePubSub
 	redisClient redispkg.IRedis
+	fanOut      *fanOut
 }
 
 // NewRedis returns redis
-func NewRedis(client redispkg.IRedis) IPubSub {
-	return &Redis{BasePubSub{&sync.Map{}}, client}
+func NewRedis(client redispkg.IRedis, queueConfig sendqueue.Config) IPubSub {
+	r := &Redis{BasePubSub: BasePubSub{&sync.Map{}}, redisClient: client}
+	switch client.(type) {
+	case *redispkg.PubSub:
+		// every redis pubsub subscription holds a connection, so all subjects share one
+		r.fanOut = newFanOut(newSharedSubscription(client), queueConfig)
+	default:
+		subscribe := func(ctx context.Context, subject string) (broker.ISubscription, error) {
+			return client.Subscribe(ctx, subject), nil
+		}
+		r.fanOut = newFanOut(newSubscriptionPerSubject(subscribe, client.UnSubscribe), queueConfig)
+	}
+	return r
 }
 
 // Publish a event to a subject
@@ -44,98 +57,103 @@ func (r *Redis) PublishBulk(ctx context.Context, request []PublishRequest) error
 
 // AsyncSubscribe to a subject
 func (r *Redis) AsyncSubscribe(ctx context.Context, subject ...string) (*subscription.Subscription, error) {
-	id, err := uuid.NewUUID()
+	return r.fanOut.subscribe(ctx, subject...)
+}
+
+// AsyncSubscribeFrom subscribes to a subject and receives events after the cursor. The subject
+// is subscribed for the subscription alone, as the events received depend on its cursor.
+func (r *Redis) AsyncSubscribeFrom(ctx context.Context, subject string, cursor string) (*subscription.Subscription, error) {
+	subs, err := r.fanOut.subscribe(ctx)
 	if err != nil {
-		pErr := perror.Newf(perror.Internal, "error in generating uuid %v", err)
-		logger.Ctx(ctx).Error(pErr.Error())
-		return nil, pErr
-	}
-	subs := &subscription.Subscription{
-		TopicEventChan: make(chan broker.TopicEvent),
-		ErrChan:        make(chan error),
-		ID:             id,
+		return nil, err
 	}
-	pubs := r.redisClient.Subscribe(ctx, subject...)
-	ch := pubs.GetTopicEventChan()
-	go func() {
-		for {
-			select {
-			case p := <-ch:
-				subs.TopicEventChan <- p
-			case <-ctx.Done():
-				logger.Ctx(ctx).Debug("stopping subscriber loop")
-				return
-			}
-		}
-	}()
-	r.BasePubSub.Store(ctx, id.String(), pubs)
+	pubs := r.redisClient.SubscribeFrom(ctx, subject, cursor)
+	go r.fanOut.forward(ctx, pubs.GetTopicEventChan(), subs)
+	r.BasePubSub.Store(ctx, subs.ID.String(), pubs)
 	return subs, nil
 }
 
-// AddSubscription ...
+// AddSubscription subscribes the subscription to a subject
 func (r *Redis) AddSubscription(ctx context.Context, subject string, subs *subscription.Subscription) error {
-	v, err := r.BasePubSub.Load(ctx, subs.ID.String())
-	if err != nil {
-		return err
-	}
-	var rs broker.ISubscription
-	switch v.(type) {
-	case broker.ISubscription:
-		rs = v.(broker.ISubscription)
-	default:
-		pErr := perror.Newf(perror.Internal, "type not defined")
-		logger.Ctx(ctx).Error(pErr)
-		return pErr
-	}
-	err = r.redisClient.AddSubscription(ctx, subject, rs)
-	if err != nil {
-		return err
-	}
-	return nil
+	return r.fanOut.add(ctx, subject, subs)
 }
 
-// RemoveSubscription ...
+// RemoveSubscription unsubscribes the subscription from a subject
 func (r *Redis) RemoveSubscription(ctx context.Context, subject string, subs *subscription.Subscription) error {
-	v, err := r.BasePubSub.Load(ctx, subs.ID.String())
-	if err != nil {
-		return err
+	return r.fanOut.remove(ctx, subject, subs)
+}
+
+// Unsubscribe from all subjects of the subscription
+func (r *Redis) Unsubscribe(ctx context.Context, subs *subscription.Subscription) error {
+	err := r.fanOut.unsubscribe(ctx, subs)
+	if v, ok := r.ChannelSubscriptionMap.LoadAndDelete(subs.ID.String()); ok {
+		uErr := r.redisClient.UnSubscribe(ctx, v.(broker.ISubscription))
+		if uErr != nil {
+			pErr := perror.Newf(perror.Internal, "unable to unsubscribe %v", uErr)
+			logger.Ctx(ctx).Errorw(pErr.Error(), "subscription", subs.ID)
+			return pErr
+		}
 	}
-	var rs broker.ISubscription
-	switch v.(type) {
-	case broker.ISubscription:
-		rs = v.(broker.ISubscription)
-	default:
-		pErr := perror.Newf(perror.Internal, "type not defined")
-		logger.Ctx(ctx).Error(pErr)
-		return pErr
+	return err
+}
+
+// sharedSubscription subscribes the node to all subjects on a single redis subscription,
+// the events received are routed to the subject they were received for
+type sharedSubscription struct {
+	client redispkg.IRedis
+
+	mu           sync.Mutex
+	subscription broker.ISubscription
+	deliverFuncs map[string]func(broker.TopicEvent)
+}
+
+func newSharedSubscription(client redispkg.IRedis) *sharedSubscription {
+	return &sharedSubscription{client: client, deliverFuncs: make(map[string]func(broker.TopicEvent))}
+}
+
+func (s *sharedSubscription) subscribe(ctx context.Context, subject string, deliver func(broker.TopicEvent)) error {
+	s.mu.Lock()
+	if s.subscription == nil {
+		// ctx is not cancelled, the subscription is held for the lifetime of the node
+		s.subscription = s.client.Subscribe(ctx)
+		go s.route(s.subscription.GetTopicEventChan())
 	}
-	err = r.redisClient.RemoveSubscription(ctx, subject, rs)
+	rs := s.subscription
+	s.deliverFuncs[subject] = deliver
+	s.mu.Unlock()
+
+	err := s.client.AddSubscription(ctx, subject, rs)
 	if err != nil {
+		s.mu.Lock()
+		delete(s.deliverFuncs, subject)
+		s.mu.Unlock()
 		return err
 	}
 	return nil
 }
 
-// Unsubscribe a subject
-func (r *Redis) Unsubscribe(ctx context.Context, subs *subscription.Subscription) error {
-	v, err := r.BasePubSub.LoadAndDelete(ctx, subs.ID.String())
-	if err != nil {
-		return err
+func (s *sharedSubscription) unsubscribe(ctx context.Context, subject string) error {
+	s.mu.Lock()
+	delete(s.deliverFuncs, subject)
+	rs := s.subscription
+	s.mu.Unlock()
+	if rs == nil {
+		return nil
 	}
-	var rs broker.ISubscription
-	switch v.(type) {
-	case broker.ISubscription:
-		rs = v.(broker.ISubscription)
-	default:
-		pErr := perror.Newf(perror.Internal, "type not defined")
-		logger.Ctx(ctx).Error(pErr)
-		return pErr
-	}
-	err = r.redisClient.UnSubscribe(ctx, rs)
-	if err != nil {
-		pErr := perror.Newf(perror.Internal, "unable to unsubscribe %v", err)
-		logger.Ctx(ctx).Errorw(pErr.Error(), "subscription", subs.ID)
-		return pErr
+	return s.client.RemoveSubscription(ctx, subject, rs)
+}
+
+func (s *sharedSubscription) route(ch chan broker.TopicEvent) {
+	for te := range ch {
+		subject := te.Subject
+		if subject == "" {
+			subject = te.Topic
+		}
+		s.mu.Lock()
+		deliver, ok := s.deliverFuncs[subject]
+		s.mu.Unlock()
+		if ok {
+			deliver(te)
+		}
 	}
-	return nil
 }
@@ -0,0 +1,56 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/sendqueue"
+	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedis_SharedSubscription(t *testing.T) {
+	initTestLogger(t)
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := redispkg.NewClient(redispkg.Config{Address: mr.Addr()})
+	redis := NewRedis(redispkg.NewPubSub(client), sendqueue.Config{})
+
+	sub1, err := redis.AsyncSubscribe(ctx, "client1", "broadcast")
+	assert.NoError(t, err)
+	sub2, err := redis.AsyncSubscribe(ctx, "client2", "broadcast")
+	assert.NoError(t, err)
+	assert.NoError(t, redis.AddSubscription(ctx, "orders.*", sub1))
+	assert.Eventually(t, func() bool {
+		return len(mr.PubSubChannels("")) == 3 && mr.PubSubNumPat() == 1
+	}, time.Second, 10*time.Millisecond)
+	// all subjects of the node are subscribed on a single redis subscription
+	assert.Equal(t, map[string]int{"broadcast": 1, "client1": 1}, mr.PubSubNumSub("broadcast", "client1"))
+
+	assert.NoError(t, redis.Publish(ctx, PublishRequest{Channel: "broadcast", Data: []byte("all")}))
+	events := receive(t, sub1, sub2)
+	assert.Equal(t, []byte("all"), events[0].Event)
+	assert.Equal(t, []byte("all"), events[1].Event)
+
+	assert.NoError(t, redis.Publish(ctx, PublishRequest{Channel: "orders.created", Data: []byte("order")}))
+	te := receive(t, sub1)[0]
+	assert.Equal(t, "orders.created", te.Topic)
+	assert.Equal(t, "orders.*", te.Subject)
+
+	assert.NoError(t, redis.Publish(ctx, PublishRequest{Channel: "client2", Data: []byte("own")}))
+	assert.Equal(t, []byte("own"), receive(t, sub2)[0].Event)
+
+	// the subjects of sub1 alone are unsubscribed
+	assert.NoError(t, redis.Unsubscribe(ctx, sub1))
+	assert.Eventually(t, func() bool {
+		channels := mr.PubSubChannels("")
+		return len(channels) == 2 && mr.PubSubNumPat() == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"broadcast", "client2"}, mr.PubSubChannels(""))
+}
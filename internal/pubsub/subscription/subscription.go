@@ -10,4 +10,7 @@ type Subscription struct {
 	TopicEventChan chan broker.TopicEvent
 	ErrChan        chan error
 	ID             uuid.UUID
+	// Overflow is closed when the queue of the subscription is full with the disconnect policy,
+	// no events are received afterwards
+	Overflow <-chan struct{}
 }
@@ -0,0 +1,100 @@
+package push
+
+import (
+	"context"
+	"path"
+
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/pkg/broker"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+)
+
+const maxFilterNames = 100
+
+// EventFilter selects the events delivered on a channel by their name
+type EventFilter struct {
+	includeNames []string
+	excludeNames []string
+}
+
+// PopulateFromProto maps model from proto, the name patterns are validated
+func (f *EventFilter) PopulateFromProto(ctx context.Context, proto *pushv1.EventFilter) error {
+	f.includeNames = proto.GetIncludeNames()
+	f.excludeNames = proto.GetExcludeNames()
+	if len(f.includeNames)+len(f.excludeNames) > maxFilterNames {
+		pErr := perror.Newf(perror.InvalidArgument, "at most %d names are allowed in a filter", maxFilterNames)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	for _, names := range [][]string{f.includeNames, f.excludeNames} {
+		for _, name := range names {
+			if _, err := path.Match(name, ""); err != nil {
+				pErr := perror.Newf(perror.InvalidArgument, "invalid event name pattern %s", name)
+				logger.Ctx(ctx).Error(pErr.Error())
+				return pErr
+			}
+		}
+	}
+	return nil
+}
+
+// Matches checks if an event with the name is to be delivered
+func (f *EventFilter) Matches(name string) bool {
+	if matchesAny(f.excludeNames, name) {
+		return false
+	}
+	return len(f.includeNames) == 0 || matchesAny(f.includeNames, name)
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ChannelFilters are the event filters of a channel and of its topic subscriptions.
+// They are owned by the channel and are not safe for concurrent use.
+type ChannelFilters struct {
+	channel *EventFilter
+	topics  map[string]*EventFilter
+}
+
+// NewChannelFilters returns ChannelFilters which deliver all events
+func NewChannelFilters() *ChannelFilters {
+	return &ChannelFilters{topics: make(map[string]*EventFilter)}
+}
+
+// SetChannelFilter sets the filter of all events of the channel, a nil filter clears it
+func (c *ChannelFilters) SetChannelFilter(filter *EventFilter) {
+	c.channel = filter
+}
+
+// SetTopicFilter sets the filter of the events of a topic subscription, a nil filter clears it
+func (c *ChannelFilters) SetTopicFilter(topic string, filter *EventFilter) {
+	if filter == nil {
+		delete(c.topics, topic)
+		return
+	}
+	c.topics[topic] = filter
+}
+
+// Matches checks if an event with the name received on the topic is to be delivered.
+// An event received for a topic pattern is checked against the filter of the pattern.
+func (c *ChannelFilters) Matches(topic string, name string) bool {
+	if c.channel != nil && !c.channel.Matches(name) {
+		return false
+	}
+	if filter, ok := c.topics[topic]; ok {
+		return filter.Matches(name)
+	}
+	for pattern, filter := range c.topics {
+		if broker.IsWildcard(pattern) && broker.MatchSubject(pattern, topic) && !filter.Matches(name) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+package push
+
+import (
+	"context"
+	"testing"
+
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventFilter_PopulateFromProto(t *testing.T) {
+	_, err := logger.NewLogger("dev", nil, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		proto   *pushv1.EventFilter
+		wantErr bool
+	}{
+		{
+			name:    "valid patterns",
+			proto:   &pushv1.EventFilter{IncludeNames: []string{"price.*"}, ExcludeNames: []string{"price.tick"}},
+			wantErr: false,
+		},
+		{
+			name:    "invalid pattern",
+			proto:   &pushv1.EventFilter{IncludeNames: []string{"price.["}},
+			wantErr: true,
+		},
+		{
+			name:    "too many names",
+			proto:   &pushv1.EventFilter{ExcludeNames: make([]string, maxFilterNames+1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &EventFilter{}
+			err := f.PopulateFromProto(ctx, tt.proto)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEventFilter_Matches(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter EventFilter
+		event  string
+		want   bool
+	}{
+		{name: "empty filter", filter: EventFilter{}, event: "price.tick", want: true},
+		{name: "included", filter: EventFilter{includeNames: []string{"order.*"}}, event: "order.placed", want: true},
+		{name: "not included", filter: EventFilter{includeNames: []string{"order.*"}}, event: "price.tick", want: false},
+		{name: "excluded", filter: EventFilter{excludeNames: []string{"price.tick"}}, event: "price.tick", want: false},
+		{
+			name:   "exclude takes precedence",
+			filter: EventFilter{includeNames: []string{"price.*"}, excludeNames: []string{"price.tick"}},
+			event:  "price.tick",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Matches(tt.event))
+		})
+	}
+}
+
+func TestChannelFilters_Matches(t *testing.T) {
+	filters := NewChannelFilters()
+	assert.True(t, filters.Matches("prices", "price.tick"))
+
+	filters.SetTopicFilter("prices", &EventFilter{excludeNames: []string{"price.tick"}})
+	filters.SetTopicFilter("orders.*", &EventFilter{includeNames: []string{"order.placed"}})
+	assert.False(t, filters.Matches("prices", "price.tick"))
+	assert.True(t, filters.Matches("prices", "price.close"))
+	// events of other topics are not filtered by the topic filter
+	assert.True(t, filters.Matches("client1", "price.tick"))
+	assert.True(t, filters.Matches("orders.eu", "order.placed"))
+	assert.False(t, filters.Matches("orders.eu", "order.updated"))
+
+	filters.SetChannelFilter(&EventFilter{excludeNames: []string{"order.*"}})
+	assert.False(t, filters.Matches("client1", "order.placed"))
+	assert.False(t, filters.Matches("orders.eu", "order.placed"))
+
+	filters.SetChannelFilter(nil)
+	filters.SetTopicFilter("prices", nil)
+	assert.True(t, filters.Matches("prices", "price.tick"))
+}
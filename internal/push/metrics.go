@@ -17,6 +17,24 @@ var (
 		Buckets: []float64{5, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 120, 140, 160, 180, 200, 250, 300, 400, 500, 600, 700, 800, 900, 1000},
 	})
 
+	channelsRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "propeller_channels_rejected_by_limit",
+			Help: "Total number of channels rejected as the channel limit of the client or device was exceeded",
+		},
+		[]string{"limit"},
+	)
+
+	channelsEvicted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_channels_evicted_total",
+		Help: "Total number of channels evicted by a newer channel of the same client or device",
+	})
+
+	sessionsReplaced = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_sessions_replaced_total",
+		Help: "Total number of connects which closed the channels of the other devices of the client",
+	})
+
 	messagesSent = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "propeller_messages_sent_by_event",
@@ -32,4 +50,27 @@ var (
 		},
 		[]string{"event"},
 	)
+
+	messagesExpired = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "propeller_messages_expired_by_event",
+			Help: "Total number of messages dropped as they expired before delivery",
+		},
+		[]string{"event"},
+	)
+
+	messagesFiltered = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "propeller_messages_filtered_by_event",
+			Help: "Total number of messages not sent as they did not match the filters of the channel",
+		},
+		[]string{"event"},
+	)
+
+	messagesDuplicate = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "propeller_messages_duplicate_total",
+			Help: "Total number of messages not sent again as they were sent earlier with the same idempotency key",
+		},
+	)
 )
@@ -25,11 +25,102 @@ func (g *GetClientActiveDevicesRequest) PopulateFromProto(ctx context.Context, p
 	return nil
 }
 
+// SubscribeClientToTopicRequest model
+type SubscribeClientToTopicRequest struct {
+	clientID string
+	deviceID string
+	topic    string
+}
+
+// PopulateFromProto maps model from proto
+func (s *SubscribeClientToTopicRequest) PopulateFromProto(ctx context.Context, proto *pushv1.SubscribeClientToTopicRequest) error {
+	s.clientID = proto.GetClientId()
+	s.deviceID = proto.GetDeviceId()
+	s.topic = proto.GetTopic()
+	return nil
+}
+
+// UnsubscribeClientFromTopicRequest model
+type UnsubscribeClientFromTopicRequest struct {
+	clientID string
+	deviceID string
+	topic    string
+}
+
+// PopulateFromProto maps model from proto
+func (u *UnsubscribeClientFromTopicRequest) PopulateFromProto(ctx context.Context, proto *pushv1.UnsubscribeClientFromTopicRequest) error {
+	u.clientID = proto.GetClientId()
+	u.deviceID = proto.GetDeviceId()
+	u.topic = proto.GetTopic()
+	return nil
+}
+
+// DisconnectClientRequest model
+type DisconnectClientRequest struct {
+	clientID string
+	deviceID string
+	reason   string
+}
+
+// PopulateFromProto maps model from proto
+func (d *DisconnectClientRequest) PopulateFromProto(ctx context.Context, proto *pushv1.DisconnectClientRequest) error {
+	d.clientID = proto.GetClientId()
+	d.deviceID = proto.GetDeviceId()
+	d.reason = proto.GetReason()
+	return nil
+}
+
+// GetClientsOnlineStatusRequest model
+type GetClientsOnlineStatusRequest struct {
+	clientIDs []string
+}
+
+// PopulateFromProto maps model from proto
+func (g *GetClientsOnlineStatusRequest) PopulateFromProto(ctx context.Context, proto *pushv1.GetClientsOnlineStatusRequest) error {
+	g.clientIDs = proto.GetClientIds()
+	return nil
+}
+
+// ClientOnlineStatus is the online status of a client
+type ClientOnlineStatus struct {
+	ClientID    string
+	IsOnline    bool
+	DeviceCount int
+}
+
+// ToProto converts to proto
+func (s ClientOnlineStatus) ToProto() *pushv1.ClientOnlineStatus {
+	return &pushv1.ClientOnlineStatus{ClientId: s.ClientID, IsOnline: s.IsOnline, DeviceCount: int32(s.DeviceCount)}
+}
+
+// GetTopicPresenceRequest model
+type GetTopicPresenceRequest struct {
+	topic string
+}
+
+// PopulateFromProto maps model from proto
+func (g *GetTopicPresenceRequest) PopulateFromProto(ctx context.Context, proto *pushv1.GetTopicPresenceRequest) error {
+	g.topic = proto.GetTopic()
+	return nil
+}
+
+// TopicPresenceMember is a client device subscribed to a topic
+type TopicPresenceMember struct {
+	ClientID string `json:"client_id"`
+	DeviceID string `json:"device_id"`
+}
+
+// ToProto converts to proto
+func (m TopicPresenceMember) ToProto() *pushv1.TopicPresenceMember {
+	return &pushv1.TopicPresenceMember{ClientId: m.ClientID, DeviceId: m.DeviceID}
+}
+
 // SendEventToClientChannelRequest model
 type SendEventToClientChannelRequest struct {
-	clientID  string
-	eventName string
-	event     []byte
+	clientID       string
+	eventName      string
+	event          []byte
+	idempotencyKey string
 }
 
 // PopulateFromProto maps model from proto
@@ -44,16 +135,18 @@ func (smu *SendEventToClientChannelRequest) PopulateFromProto(ctx context.Contex
 		}
 		smu.event = eventBytes
 		smu.eventName = protoRequest.Event.Name
+		smu.idempotencyKey = protoRequest.Event.IdempotencyKey
 	}
 	return nil
 }
 
 // SendEventToClientDeviceChannelRequest model
 type SendEventToClientDeviceChannelRequest struct {
-	clientID  string
-	deviceID  string
-	eventName string
-	event     []byte
+	clientID       string
+	deviceID       string
+	eventName      string
+	event          []byte
+	idempotencyKey string
 }
 
 // PopulateFromProto maps model from proto
@@ -69,10 +162,114 @@ func (smu *SendEventToClientDeviceChannelRequest) PopulateFromProto(ctx context.
 		}
 		smu.event = eventBytes
 		smu.eventName = protoRequest.Event.Name
+		smu.idempotencyKey = protoRequest.Event.IdempotencyKey
 	}
 	return nil
 }
 
+// Recipient of an event, a client optionally scoped to a device
+type Recipient struct {
+	ClientID string
+	DeviceID string
+}
+
+// ToProto converts to proto
+func (r Recipient) ToProto() *pushv1.Recipient {
+	return &pushv1.Recipient{ClientId: r.ClientID, DeviceId: r.DeviceID}
+}
+
+// RecipientStatus is the result of sending an event to a recipient, Err is nil on success
+type RecipientStatus struct {
+	Recipient Recipient
+	Err       error
+	// Duplicate is set if the event was already sent to the recipient with the same idempotency key
+	Duplicate bool
+}
+
+// SendEventToClientsRequest model
+type SendEventToClientsRequest struct {
+	recipients     []Recipient
+	eventName      string
+	event          []byte
+	idempotencyKey string
+}
+
+// PopulateFromProto maps model from proto
+func (smc *SendEventToClientsRequest) PopulateFromProto(ctx context.Context, protoRequest *pushv1.SendEventToClientsRequest) error {
+	for _, r := range protoRequest.GetRecipients() {
+		smc.recipients = append(smc.recipients, Recipient{ClientID: r.GetClientId(), DeviceID: r.GetDeviceId()})
+	}
+	if protoRequest.Event != nil {
+		eventBytes, err := proto.Marshal(protoRequest.Event)
+		if err != nil {
+			pErr := perror.Newf(perror.Internal, "unable to marshall proto")
+			logger.Ctx(ctx).Error(pErr.Error())
+			return pErr
+		}
+		smc.event = eventBytes
+		smc.eventName = protoRequest.Event.Name
+		smc.idempotencyKey = protoRequest.Event.IdempotencyKey
+	}
+	return nil
+}
+
+// SendRequestToClientRequest model
+type SendRequestToClientRequest struct {
+	clientID string
+	deviceID string
+	event    *pushv1.Event
+	timeout  time.Duration
+}
+
+// PopulateFromProto maps model from proto
+func (sr *SendRequestToClientRequest) PopulateFromProto(ctx context.Context, protoRequest *pushv1.SendRequestToClientRequest) error {
+	sr.clientID = protoRequest.GetClientId()
+	sr.deviceID = protoRequest.GetDeviceId()
+	sr.event = protoRequest.GetEvent()
+	sr.timeout = time.Duration(protoRequest.GetTimeoutInMs()) * time.Millisecond
+	return nil
+}
+
+// BroadcastEventRequest model
+type BroadcastEventRequest struct {
+	eventName string
+	// broadcast is the proto request as is, so that the attribute filter reaches every node
+	broadcast      []byte
+	idempotencyKey string
+}
+
+// PopulateFromProto maps model from proto
+func (b *BroadcastEventRequest) PopulateFromProto(ctx context.Context, protoRequest *pushv1.BroadcastEventRequest) error {
+	if protoRequest.Event != nil {
+		broadcastBytes, err := proto.Marshal(protoRequest)
+		if err != nil {
+			pErr := perror.Newf(perror.Internal, "unable to marshall proto")
+			logger.Ctx(ctx).Error(pErr.Error())
+			return pErr
+		}
+		b.broadcast = broadcastBytes
+		b.eventName = protoRequest.Event.Name
+		b.idempotencyKey = protoRequest.Event.IdempotencyKey
+	}
+	return nil
+}
+
+// isExpired checks if the event has an expiry which has passed
+func isExpired(event *pushv1.Event, now time.Time) bool {
+	expiresAt := event.GetExpiresAt()
+	return expiresAt != nil && !now.Before(expiresAt.AsTime())
+}
+
+// matchesAttributeFilter checks if attributes have every key value pair of the filter
+func matchesAttributeFilter(filter map[string]string, attributes map[string]string) bool {
+	for k, v := range filter {
+		if attr, ok := attributes[k]; !ok || attr != v {
+			return false
+		}
+	}
+	return true
+}
+
 // TopicSubscriptionRequest model
 type TopicSubscriptionRequest struct {
 	topicToSubscribe string
@@ -85,9 +282,10 @@ type TopicUnSubscriptionRequest struct {
 
 // SendEventToTopicRequest model
 type SendEventToTopicRequest struct {
-	Topic     string
-	EventName string
-	Event     []byte
+	Topic          string
+	EventName      string
+	Event          []byte
+	IdempotencyKey string
 }
 
 // PopulateFromProto maps model from proto
@@ -102,6 +300,7 @@ func (smt *SendEventToTopicRequest) PopulateFromProto(ctx context.Context, proto
 		}
 		smt.Event = eventBytes
 		smt.EventName = protoRequest.Event.Name
+		smt.IdempotencyKey = protoRequest.Event.IdempotencyKey
 	}
 	return nil
 }
@@ -161,11 +360,69 @@ func getDummyJSONBytes() []byte {
 	return jsonBytes
 }
 
+// TopicRestoreMode is how the persisted topics of a client are restored on connect
+type TopicRestoreMode string
+
+const (
+	// TopicRestoreDefault subscribes the channel to the persisted topics
+	TopicRestoreDefault TopicRestoreMode = ""
+	// TopicRestoreSkip doesn't subscribe the channel to the persisted topics, which are kept
+	TopicRestoreSkip TopicRestoreMode = "skip"
+	// TopicRestoreReset deletes the persisted topics
+	TopicRestoreReset TopicRestoreMode = "reset"
+)
+
+// ChannelOptions are passed by the client on connecting a channel
+type ChannelOptions struct {
+	// Cursor of the last event received by the client, to resume the channel from
+	Cursor       string
+	TopicRestore TopicRestoreMode
+	// Topics to subscribe to on connect
+	Topics []string
+}
+
+// TopicStatus is the result of subscribing to a topic, Err is nil on success
+type TopicStatus struct {
+	Topic string
+	Err   error
+}
+
+// ConnectResult is the result of subscribing a channel on connect
+type ConnectResult struct {
+	// RestoredTopics are the persisted topics the channel is subscribed to
+	RestoredTopics []string
+	// Topics are the results of subscribing to the topics of the channel options
+	Topics []TopicStatus
+}
+
 // Device struct for device and attrs
 type Device struct {
 	ID         string
 	Attributes map[string]string
 	LoggedInAt time.Time
+	LastSeenAt time.Time
+}
+
+// connectionRecord is the kv value of a channel of a client
+type connectionRecord struct {
+	DeviceID string `json:"device_id"`
+	// NodeID of the node holding the channel
+	NodeID      string    `json:"node_id"`
+	ConnectedAt time.Time `json:"connected_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+}
+
+// presenceRecord is the kv value of a channel subscribed to a topic
+type presenceRecord struct {
+	TopicPresenceMember
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// deviceRecord is the kv value of a device of a client
+type deviceRecord struct {
+	Attributes map[string]string `json:"attributes"`
+	LoggedInAt time.Time         `json:"logged_in_at"`
+	LastSeenAt time.Time         `json:"last_seen_at"`
 }
 
 // ToProto converts to proto
@@ -173,6 +430,7 @@ func (d Device) ToProto() *pushv1.Device {
 	device := &pushv1.Device{}
 	device.Id = d.ID
 	device.LoggedInAt = timestamppb.New(d.LoggedInAt)
+	device.LastSeenAt = timestamppb.New(d.LastSeenAt)
 	device.Attributes = d.Attributes
 	return device
 }
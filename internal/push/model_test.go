@@ -7,6 +7,7 @@ import (
 
 	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -144,6 +145,103 @@ func TestSendEventToClientDeviceChannelRequest_PopulateFromProto(t *testing.T) {
 	}
 }
 
+func TestSendEventToClientsRequest_PopulateFromProto(t *testing.T) {
+	ctx := context.Background()
+	testData, _ := anypb.New(&timestamppb.Timestamp{Seconds: 1234})
+	tests := []struct {
+		name    string
+		proto   *pushv1.SendEventToClientsRequest
+		want    []Recipient
+		wantErr bool
+	}{
+		{
+			name: "valid request",
+			proto: &pushv1.SendEventToClientsRequest{
+				Recipients: []*pushv1.Recipient{
+					{ClientId: "client1"},
+					{ClientId: "client2", DeviceId: "device2"},
+				},
+				Event: &pushv1.Event{
+					Name: "test-event",
+					Data: testData,
+				},
+			},
+			want: []Recipient{
+				{ClientID: "client1"},
+				{ClientID: "client2", DeviceID: "device2"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil event",
+			proto: &pushv1.SendEventToClientsRequest{
+				Recipients: []*pushv1.Recipient{{ClientId: "client1"}},
+				Event:      nil,
+			},
+			want:    []Recipient{{ClientID: "client1"}},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			smc := &SendEventToClientsRequest{}
+			err := smc.PopulateFromProto(ctx, tt.proto)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, smc.recipients)
+			if tt.proto.Event != nil {
+				assert.Equal(t, tt.proto.Event.Name, smc.eventName)
+				assert.NotNil(t, smc.event)
+			}
+		})
+	}
+}
+
+func TestSendRequestToClientRequest_PopulateFromProto(t *testing.T) {
+	ctx := context.Background()
+	testData, _ := anypb.New(&timestamppb.Timestamp{Seconds: 1234})
+	tests := []struct {
+		name        string
+		proto       *pushv1.SendRequestToClientRequest
+		wantTimeout time.Duration
+	}{
+		{
+			name: "valid request",
+			proto: &pushv1.SendRequestToClientRequest{
+				ClientId:    "test-client",
+				DeviceId:    "test-device",
+				Event:       &pushv1.Event{Name: "test-event", Data: testData},
+				TimeoutInMs: 1500,
+			},
+			wantTimeout: 1500 * time.Millisecond,
+		},
+		{
+			name: "without timeout",
+			proto: &pushv1.SendRequestToClientRequest{
+				ClientId: "test-client",
+				Event:    &pushv1.Event{Name: "test-event", Data: testData},
+			},
+			wantTimeout: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sr := &SendRequestToClientRequest{}
+			err := sr.PopulateFromProto(ctx, tt.proto)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.proto.GetClientId(), sr.clientID)
+			assert.Equal(t, tt.proto.GetDeviceId(), sr.deviceID)
+			assert.Equal(t, tt.proto.GetEvent(), sr.event)
+			assert.Equal(t, tt.wantTimeout, sr.timeout)
+		})
+	}
+}
+
 func TestSendEventToTopicRequest_PopulateFromProto(t *testing.T) {
 	ctx := context.Background()
 	testData, _ := anypb.New(&timestamppb.Timestamp{Seconds: 1234})
@@ -163,6 +261,18 @@ func TestSendEventToTopicRequest_PopulateFromProto(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "with idempotency key",
+			proto: &pushv1.SendEventToTopicRequest{
+				Topic: "test-topic",
+				Event: &pushv1.Event{
+					Name:           "test-event",
+					Data:           testData,
+					IdempotencyKey: "key",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "nil event",
 			proto: &pushv1.SendEventToTopicRequest{
@@ -186,6 +296,7 @@ func TestSendEventToTopicRequest_PopulateFromProto(t *testing.T) {
 			if tt.proto.Event != nil {
 				assert.Equal(t, tt.proto.Event.Name, smt.EventName)
 				assert.NotNil(t, smt.Event)
+				assert.Equal(t, tt.proto.Event.IdempotencyKey, smt.IdempotencyKey)
 			}
 		})
 	}
@@ -241,6 +352,127 @@ func TestSendEventToTopicsRequest_PopulateFromProto(t *testing.T) {
 	}
 }
 
+func TestBroadcastEventRequest_PopulateFromProto(t *testing.T) {
+	ctx := context.Background()
+	testData, _ := anypb.New(&timestamppb.Timestamp{Seconds: 1234})
+	tests := []struct {
+		name    string
+		proto   *pushv1.BroadcastEventRequest
+		wantErr bool
+	}{
+		{
+			name: "valid request",
+			proto: &pushv1.BroadcastEventRequest{
+				Event: &pushv1.Event{
+					Name: "test-event",
+					Data: testData,
+				},
+				AttributeFilter: map[string]string{"x-os": "android"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil event",
+			proto: &pushv1.BroadcastEventRequest{
+				Event: nil,
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &BroadcastEventRequest{}
+			err := b.PopulateFromProto(ctx, tt.proto)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.proto.Event == nil {
+				assert.Nil(t, b.broadcast)
+				return
+			}
+			assert.Equal(t, tt.proto.Event.Name, b.eventName)
+			got := &pushv1.BroadcastEventRequest{}
+			assert.NoError(t, proto.Unmarshal(b.broadcast, got))
+			assert.Equal(t, tt.proto.AttributeFilter, got.AttributeFilter)
+			assert.Equal(t, tt.proto.Event.Name, got.Event.Name)
+		})
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name  string
+		event *pushv1.Event
+		want  bool
+	}{
+		{
+			name:  "without expiry",
+			event: &pushv1.Event{Name: "test-event"},
+			want:  false,
+		},
+		{
+			name:  "expires later",
+			event: &pushv1.Event{Name: "test-event", ExpiresAt: timestamppb.New(now.Add(time.Minute))},
+			want:  false,
+		},
+		{
+			name:  "expired",
+			event: &pushv1.Event{Name: "test-event", ExpiresAt: timestamppb.New(now.Add(-time.Minute))},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isExpired(tt.event, now))
+		})
+	}
+}
+
+func TestMatchesAttributeFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     map[string]string
+		attributes map[string]string
+		want       bool
+	}{
+		{
+			name:       "empty filter",
+			filter:     nil,
+			attributes: nil,
+			want:       true,
+		},
+		{
+			name:       "all attributes match",
+			filter:     map[string]string{"x-os": "android"},
+			attributes: map[string]string{"x-os": "android", "x-os-version": "14"},
+			want:       true,
+		},
+		{
+			name:       "attribute value differs",
+			filter:     map[string]string{"x-os": "android"},
+			attributes: map[string]string{"x-os": "ios"},
+			want:       false,
+		},
+		{
+			name:       "attribute missing",
+			filter:     map[string]string{"x-os": "android", "x-os-version": "14"},
+			attributes: map[string]string{"x-os": "android"},
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesAttributeFilter(tt.filter, tt.attributes))
+		})
+	}
+}
+
 func TestDevice_ToProto(t *testing.T) {
 	now := time.Now()
 	tests := []struct {
@@ -4,70 +4,86 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
 	"time"
 
+	"github.com/CRED-CLUB/propeller/internal/ack"
+	"github.com/CRED-CLUB/propeller/internal/authz"
+	"github.com/CRED-CLUB/propeller/internal/cluster"
 	"github.com/CRED-CLUB/propeller/internal/config"
+	"github.com/CRED-CLUB/propeller/internal/inbox"
 	"github.com/CRED-CLUB/propeller/internal/kv"
 	"github.com/CRED-CLUB/propeller/internal/perror"
 	"github.com/CRED-CLUB/propeller/internal/pubsub"
 	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
+	"github.com/CRED-CLUB/propeller/internal/upstream"
+	"github.com/CRED-CLUB/propeller/pkg/broker"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
 	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	// DeviceValidation is topic name for device validation messages
-	DeviceValidation string = "DEVICE_VALIDATION"
+	// ControlEventName is the name of events carrying a control event for channels, which are not delivered to clients
+	ControlEventName string = "PROPELLER_CONTROL"
+	// SessionReplacedEventName is the name of the event sent to the other devices of a client before their
+	// channels are closed, when a device connects with single active device mode
+	SessionReplacedEventName string = "SESSION_REPLACED"
+	// PresenceJoin is the name of the event sent to a topic when a client subscribes to it
+	PresenceJoin string = "PRESENCE_JOIN"
+	// PresenceLeave is the name of the event sent to a topic when a client unsubscribes from it
+	PresenceLeave string = "PRESENCE_LEAVE"
+
+	defaultDeviceTTL         = 30 * time.Second
+	maxOnlineStatusClients   = 5000
+	maxConnectTopics         = 100
+	defaultRequestTimeout    = 5 * time.Second
+	maxRequestTimeout        = 60 * time.Second
+	defaultIdempotencyWindow = 10 * time.Minute
+
+	// ChannelLimitReject rejects a channel over the limits
+	ChannelLimitReject string = "reject"
+	// ChannelLimitEvictOldest closes the oldest channels to make room for a channel over the limits
+	ChannelLimitEvictOldest string = "evict_oldest"
+
+	// topicOriginClient is the origin of a persisted topic subscribed to by the client
+	topicOriginClient = "client"
+	// topicOriginServer is the origin of a persisted topic subscribed to on request of the backend
+	topicOriginServer = "server"
 )
 
 // Service of the push service
 type Service struct {
-	pubSub           pubsub.IPubSub
-	kv               kv.IKV
-	config           config.Config
-	sessionStartTime time.Time
-}
-
-// NewService returns a new instance of Service
-func NewService(pubSub pubsub.IPubSub, kv kv.IKV, config config.Config) *Service {
-	return &Service{pubSub: pubSub, kv: kv, config: config}
-}
-
-// GetClientActiveDevices ...
-/*
-	                                      ┌──────┐
-	      2. subscribe(clnt, clnt-device) │      │◄──────────┐
-	                                 ┌───►│redis │           │
-	                  ┌───────────┐  │    │pubsub├──────┐    │
-	┌───┐             │           ├──┘    ├───▲──┘      │    │
-	│   │1. connect   │           │       │   │         │    │
-	│app├────────────►│propeller  │◄──────┘   │         │    │
-	│   │             │           │9.         │         │    │8.
-	└───┘             │           ├───────────┘         │    │
-	                  │           │10. validation resp  │    │validation
-	                  └───────────┴──┐    ┌───────┐     │    │message
-	                                 │    │       │     │    │published
-	   3. store(clnt, device, attrs) │    │ redis │     │    │
-	                                 └───►│  kv   │     │11. │
-	                                      │       │     │    │
-	                                      └───────┘     │    │
-	                    5. devices = load(clnt)         │    │
-	            13.delete stale entries   ┌──────┐      │    │
-	                  ┌────────────┐      │      │      │    │
-	                  │            ├─────►│redis │      │    │
-	┌───┐             │            │      │ kv   │      │    │
-	│   │4.getDevices │            │      └──────┘      │    │
-	│ BE├────────────►│propeller   │12. validation recv │    │
-	│   │             │            │◄─────┬──────┐      │    │
-	│   │14. reply    │            │6.    │      │◄─────┘    │
-	│   │◄────────────┤            ├─────►│redis │           │
-	└───┘             │            │7.    │pubsub├───────────┘
-	                  └────────────┴─────►└──────┘
-	        6. subscribe(clnt-device-resp)
-	   7. publishToTopic(clnt-device, validation message)
-*/
+	pubSub pubsub.IPubSub
+	// controlPubSub carries control events, which are not persisted so that every channel of a client receives them
+	controlPubSub pubsub.IPubSub
+	kv            kv.IKV
+	inbox         inbox.IInbox
+	upstream      *upstream.Router
+	authorizer    authz.IAuthorizer
+	nodes         *cluster.Registry
+	sessions      *SessionRegistry
+	config        config.Config
+}
+
+// NewService returns a new instance of Service, inbox is nil if disabled
+func NewService(pubSub pubsub.IPubSub, controlPubSub pubsub.IPubSub, kv kv.IKV, inbox inbox.IInbox, authorizer authz.IAuthorizer, nodes *cluster.Registry, config config.Config) *Service {
+	return &Service{pubSub: pubSub, controlPubSub: controlPubSub, kv: kv, inbox: inbox, upstream: upstream.NewRouter(controlPubSub, config.Upstream), authorizer: authorizer, nodes: nodes, sessions: NewSessionRegistry(), config: config}
+}
+
+// ListNodes returns the live nodes of the cluster
+func (c *Service) ListNodes(ctx context.Context) ([]cluster.Node, error) {
+	logger.Ctx(ctx).Infow("listing nodes")
+	return c.nodes.ListNodes(ctx)
+}
+
+// GetClientActiveDevices returns the devices of a client with a live channel on any node.
+// The record of a device is refreshed by its node while the channel is open, so a record
+// which is not refreshed within the device ttl is stale and deleted.
 func (c *Service) GetClientActiveDevices(ctx context.Context, req GetClientActiveDevicesRequest) ([]Device, error) {
 	logger.Ctx(ctx).Infow("getting client devices")
 	if !c.config.EnableDeviceSupport {
@@ -78,123 +94,466 @@ func (c *Service) GetClientActiveDevices(ctx context.Context, req GetClientActiv
 		return nil, perror.New(perror.InvalidArgument, "client id required")
 	}
 
-	// load device entries from kv store
 	v, err := c.kv.Load(ctx, req.clientID)
 	if err != nil {
-		logger.Ctx(ctx).Errorw("error in getting attr", "attr", v, "err", err)
+		return nil, err
 	}
 
-	if len(v) == 0 {
-		return []Device{}, nil
+	now := time.Now()
+	result := []Device{}
+	var staleDeviceIDs []string
+	for deviceID, value := range v {
+		var record deviceRecord
+		err := json.Unmarshal([]byte(value), &record)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error unmarshalling device record", "deviceID", deviceID, "err", err)
+			staleDeviceIDs = append(staleDeviceIDs, deviceID)
+			continue
+		}
+		if !c.isDeviceAlive(record, now) {
+			staleDeviceIDs = append(staleDeviceIDs, deviceID)
+			continue
+		}
+		result = append(result, Device{
+			ID:         deviceID,
+			Attributes: record.Attributes,
+			LoggedInAt: record.LoggedInAt,
+			LastSeenAt: record.LastSeenAt,
+		})
 	}
-
-	// prepare to send validation pubsub message for devices found
-	var foundDevices []Device
-	var foundDeviceIDs []string
-	for deviceID, attrs := range v {
-		// TODO: fix
-		logger.Ctx(ctx).Infow("found device", "deviceID", deviceID, "attrs", attrs)
-		attrsMap := make(map[string]string)
-		err := json.Unmarshal([]byte(attrs), &attrsMap)
+	if len(staleDeviceIDs) > 0 {
+		err = c.kv.Delete(ctx, req.clientID, staleDeviceIDs...)
 		if err != nil {
-			logger.Ctx(ctx).Errorw("error unmarshalling attrs", "err", err)
-			return []Device{}, err
+			logger.Ctx(ctx).Errorw("error in deleting stale devices", "err", err)
 		}
-		foundDevices = append(foundDevices, Device{ID: deviceID, Attributes: attrsMap})
-		foundDeviceIDs = append(foundDeviceIDs, fmt.Sprintf("%s#%s#%s", req.clientID, deviceID, "resp"))
 	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
 
-	// subscribe to response channels
-	s, err := c.pubSub.AsyncSubscribe(ctx, foundDeviceIDs...)
+// storeDevice stores the record of the device as seen now
+func (c *Service) storeDevice(ctx context.Context, clientID string, device *Device) error {
+	v, err := json.Marshal(deviceRecord{Attributes: device.Attributes, LoggedInAt: device.LoggedInAt, LastSeenAt: time.Now()})
 	if err != nil {
-		return nil, err
+		pErr := perror.Newf(perror.Internal, "unable to marshal device record %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return c.kv.Store(ctx, clientID, device.ID, string(v))
+}
+
+// refreshDevice keeps the record of the device alive till the channel is closed
+func (c *Service) refreshDevice(ctx context.Context, clientID string, device *Device) {
+	ticker := time.NewTicker(c.deviceTTL() / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := c.storeDevice(ctx, clientID, device)
+			if err != nil {
+				logger.Ctx(ctx).Errorf("error in refreshing device details %+v", err)
+			}
+		}
 	}
-	ch := make(chan map[string]bool)
-	go c.receiveDeviceResponse(ctx, s, ch, len(v))
+}
 
-	// publish validation message
-	for k := range v {
-		topic := fmt.Sprintf("%s--%s", req.clientID, k)
+// storeConnection stores the record of the channel of the session
+func (c *Service) storeConnection(ctx context.Context, session *Session) error {
+	v, err := json.Marshal(connectionRecord{
+		DeviceID:    session.DeviceID(),
+		NodeID:      c.nodes.NodeID(),
+		ConnectedAt: session.StartedAt,
+		LastSeenAt:  time.Now(),
+	})
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to marshal connection record %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return c.kv.Store(ctx, connectionsKey(session.ClientID), session.ID, string(v))
+}
 
-		eventToPublish := pushv1.Event{
-			Name:       DeviceValidation,
-			FormatType: pushv1.Event_TYPE_JSON_UNSPECIFIED,
-			Data: &anypb.Any{
-				TypeUrl: "",
-				Value:   []byte(k),
-			},
+// refreshConnection keeps the record and the topic presence of the channel alive till it is closed, so
+// that channels of a crashed node are not considered online, counted against the limits, routed to nor
+// listed as topic members
+func (c *Service) refreshConnection(ctx context.Context, session *Session) {
+	ticker := time.NewTicker(c.deviceTTL() / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := c.storeConnection(ctx, session)
+			if err != nil {
+				logger.Ctx(ctx).Errorf("error in refreshing connection %+v", err)
+			}
+			c.refreshPresence(ctx, session)
 		}
-		pbEvent, err := proto.Marshal(&eventToPublish)
-		if err != nil {
-			return nil, err
+	}
+}
+
+// isChannelLimited checks if the channels of a client or a device are limited
+func (c *Service) isChannelLimited() bool {
+	return c.config.MaxChannelsPerClient > 0 || c.config.MaxChannelsPerDevice > 0
+}
+
+// enforceChannelLimits checks the live channels of the client, on any node, before a channel of the device is
+// connected. Over a limit the channel is rejected, or the oldest channels are evicted with ChannelLimitEvictOldest.
+// Channels connecting concurrently may exceed a limit briefly, till one of them connects again.
+func (c *Service) enforceChannelLimits(ctx context.Context, clientID string, device *Device) error {
+	if !c.isChannelLimited() {
+		return nil
+	}
+	v, err := c.kv.Load(ctx, connectionsKey(clientID))
+	if err != nil {
+		// do not block channels on a kv failure
+		logger.Ctx(ctx).Errorw("error in loading connections", "error", err.Error())
+		return nil
+	}
+
+	type connection struct {
+		id     string
+		record connectionRecord
+	}
+	now := time.Now()
+	var connections []connection
+	var staleIDs []string
+	for id, value := range v {
+		var record connectionRecord
+		err := json.Unmarshal([]byte(value), &record)
+		if err != nil || !c.isConnectionAlive(record, now) {
+			staleIDs = append(staleIDs, id)
+			continue
 		}
-		err = c.PublishToTopic(ctx, SendEventToTopicRequest{
-			Topic: topic,
-			Event: pbEvent,
-		})
+		connections = append(connections, connection{id: id, record: record})
+	}
+	if len(staleIDs) > 0 {
+		err = c.kv.Delete(ctx, connectionsKey(clientID), staleIDs...)
 		if err != nil {
+			logger.Ctx(ctx).Errorw("error in deleting stale connections", "error", err.Error())
 		}
 	}
-	responses := <-ch
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].record.ConnectedAt.Before(connections[j].record.ConnectedAt)
+	})
 
-	var result []Device
-	// prepare the result, delete stray device entries
-	for _, attrs := range foundDevices {
-		_, ok := responses[attrs.ID]
-		if ok {
-			result = append(result, Device{
-				ID:         attrs.ID,
-				Attributes: attrs.Attributes,
-			})
-		} else {
-			_ = c.kv.Delete(ctx, req.clientID, attrs.ID)
+	var deviceID string
+	if device != nil {
+		deviceID = device.ID
+	}
+	evict := make(map[string]bool)
+	// the channels of the device are evicted first, as they count against both limits
+	if c.config.MaxChannelsPerDevice > 0 && deviceID != "" {
+		var deviceConnections []connection
+		for _, conn := range connections {
+			if conn.record.DeviceID == deviceID {
+				deviceConnections = append(deviceConnections, conn)
+			}
+		}
+		if excess := len(deviceConnections) - c.config.MaxChannelsPerDevice + 1; excess > 0 {
+			if c.config.ChannelLimitPolicy != ChannelLimitEvictOldest {
+				channelsRejected.WithLabelValues("device").Inc()
+				return perror.Newf(perror.ResourceExhausted, "at most %d channels are allowed per device", c.config.MaxChannelsPerDevice)
+			}
+			for _, conn := range deviceConnections[:excess] {
+				evict[conn.id] = true
+			}
 		}
 	}
-	return result, nil
+	if c.config.MaxChannelsPerClient > 0 {
+		if excess := len(connections) - len(evict) - c.config.MaxChannelsPerClient + 1; excess > 0 {
+			if c.config.ChannelLimitPolicy != ChannelLimitEvictOldest {
+				channelsRejected.WithLabelValues("client").Inc()
+				return perror.Newf(perror.ResourceExhausted, "at most %d channels are allowed per client", c.config.MaxChannelsPerClient)
+			}
+			for _, conn := range connections {
+				if excess == 0 {
+					break
+				}
+				if !evict[conn.id] {
+					evict[conn.id] = true
+					excess--
+				}
+			}
+		}
+	}
+
+	for id := range evict {
+		c.evictChannel(ctx, clientID, id)
+	}
+	return nil
 }
 
-func (c *Service) receiveDeviceResponse(ctx context.Context, s *subscription.Subscription, ch chan map[string]bool, expectedCount int) {
-	responses := make(map[string]bool)
-	timeout := time.NewTimer(1 * time.Second)
-	receivedSoFar := 0
-	for {
-		select {
-		case topicEvent := <-s.TopicEventChan:
-			receivedSoFar++
-			protoEvent := &pushv1.Event{}
-			msg := topicEvent.Event
-			err := proto.Unmarshal(msg, protoEvent)
+// evictChannel closes the channel of the client on the node holding it, its record is deleted
+// right away so that it is not counted again
+func (c *Service) evictChannel(ctx context.Context, clientID string, channelID string) {
+	logger.Ctx(ctx).Infow("evicting channel", "channelID", channelID)
+	err := c.publishControlEvent(ctx, clientID, &pushv1.ControlEvent{
+		Control: &pushv1.ControlEvent_Disconnect{Disconnect: &pushv1.DisconnectControl{
+			Reason:     "evicted as the channel limit was exceeded by a newer channel",
+			ChannelIds: []string{channelID},
+		}},
+	})
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in evicting channel", "channelID", channelID, "error", err.Error())
+		return
+	}
+	channelsEvicted.Inc()
+	err = c.kv.Delete(ctx, connectionsKey(clientID), channelID)
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in deleting evicted connection", "error", err.Error())
+	}
+}
+
+// isConnectionAlive checks if the connection record was refreshed within the device ttl
+func (c *Service) isConnectionAlive(record connectionRecord, now time.Time) bool {
+	return now.Sub(record.LastSeenAt) <= c.deviceTTL()
+}
+
+// GetClientsOnlineStatus returns the online status of the clients in the order of the request, reading
+// the devices, or the connections if device support is disabled, of all the clients in one batch
+func (c *Service) GetClientsOnlineStatus(ctx context.Context, req GetClientsOnlineStatusRequest) ([]ClientOnlineStatus, error) {
+	logger.Ctx(ctx).Infow("getting clients online status", "count", len(req.clientIDs))
+	if len(req.clientIDs) == 0 {
+		return nil, perror.New(perror.InvalidArgument, "client ids required")
+	}
+	if len(req.clientIDs) > maxOnlineStatusClients {
+		return nil, perror.Newf(perror.InvalidArgument, "at most %d client ids are allowed", maxOnlineStatusClients)
+	}
+
+	keys := make([]string, len(req.clientIDs))
+	for i, clientID := range req.clientIDs {
+		if clientID == "" {
+			return nil, perror.New(perror.InvalidArgument, "client id is empty")
+		}
+		keys[i] = connectionsKey(clientID)
+		if c.config.EnableDeviceSupport {
+			keys[i] = clientID
+		}
+	}
+	values, err := c.kv.LoadBulk(ctx, keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	statuses := make([]ClientOnlineStatus, len(req.clientIDs))
+	for i, clientID := range req.clientIDs {
+		statuses[i] = ClientOnlineStatus{ClientID: clientID}
+		if !c.config.EnableDeviceSupport {
+			// the records of the channels of a crashed node are left behind till they are stale
+			for _, value := range values[i] {
+				var record connectionRecord
+				if json.Unmarshal([]byte(value), &record) == nil && c.isConnectionAlive(record, now) {
+					statuses[i].IsOnline = true
+					break
+				}
+			}
+			continue
+		}
+		// a channel requires a device with device support, so a client is online if any device is alive
+		for _, value := range values[i] {
+			var record deviceRecord
+			if json.Unmarshal([]byte(value), &record) == nil && c.isDeviceAlive(record, now) {
+				statuses[i].DeviceCount++
+			}
+		}
+		statuses[i].IsOnline = statuses[i].DeviceCount > 0
+	}
+	return statuses, nil
+}
+
+// isDeviceAlive checks if the device record was refreshed within the device ttl
+func (c *Service) isDeviceAlive(record deviceRecord, now time.Time) bool {
+	return now.Sub(record.LastSeenAt) <= c.deviceTTL()
+}
+
+func (c *Service) deviceTTL() time.Duration {
+	if c.config.DeviceTTLInSec > 0 {
+		return time.Duration(c.config.DeviceTTLInSec) * time.Second
+	}
+	return defaultDeviceTTL
+}
+
+// awaitReplies collects reply events of the target received on the subscription till expectedCount replies
+// are received, the timeout elapses or ctx is done, and then closes the subscription
+func (c *Service) awaitReplies(ctx context.Context, s *subscription.Subscription, target Recipient, expectedCount int, timeout time.Duration) <-chan []*pushv1.Event {
+	ch := make(chan []*pushv1.Event, 1)
+	go func() {
+		var replies []*pushv1.Event
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		defer func() {
+			ch <- replies
+			err := c.controlPubSub.Unsubscribe(context.WithoutCancel(ctx), s)
 			if err != nil {
-				return
+				logger.Ctx(ctx).Errorw("error in unsubscribing replies", "error", err.Error())
 			}
-			responses[string(protoEvent.GetData().Value)] = true
-			if receivedSoFar == expectedCount {
-				ch <- responses
+		}()
+		for {
+			select {
+			case topicEvent := <-s.TopicEventChan:
+				reply := &pushv1.UpstreamEvent{}
+				err := proto.Unmarshal(topicEvent.Event, reply)
+				if err != nil {
+					logger.Ctx(ctx).Errorw("error in unmarshalling reply", "error", err.Error())
+					break
+				}
+				if !isReplyFrom(reply, target) {
+					logger.Ctx(ctx).Errorw("ignoring reply from another client", "clientID", reply.GetClientId(), "deviceID", reply.GetDeviceId())
+					break
+				}
+				replies = append(replies, reply.GetChannelEvent().GetEvent())
+				if len(replies) == expectedCount {
+					return
+				}
+			case err := <-s.ErrChan:
+				logger.Ctx(ctx).Errorw("error in subscriber", "error", err.Error())
+			case <-s.Overflow:
+				logger.Ctx(ctx).Errorw("too many replies, stopped waiting for replies", "count", len(replies))
+				return
+			case <-timer.C:
+				return
+			case <-ctx.Done():
 				return
 			}
-		case err := <-s.ErrChan:
-			logger.Ctx(ctx).Errorw("error in subscriber", "error", err.Error())
-		case <-timeout.C:
-			ch <- responses
-			return
 		}
+	}()
+	return ch
+}
+
+// RequestClient sends an event to a client, or a device of the client, and waits for the reply
+func (c *Service) RequestClient(ctx context.Context, req SendRequestToClientRequest) (*pushv1.Event, error) {
+	logger.Ctx(ctx).Infow("sending request to client")
+
+	err := c.validateSendRequestToClientRequest(req)
+	if err != nil {
+		return nil, perror.New(perror.InvalidArgument, err.Error())
+	}
+	target := Recipient{ClientID: req.clientID, DeviceID: req.deviceID}
+	channel, err := c.getRecipientChannel(target)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultRequestTimeout
+	if req.timeout > 0 {
+		timeout = min(req.timeout, maxRequestTimeout)
+	}
+
+	event := proto.Clone(req.event).(*pushv1.Event)
+	event.CorrelationId = uuid.NewString()
+	eventBytes, err := proto.Marshal(event)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to marshall proto")
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+
+	// subscribe before publishing so that an early reply is not missed, replies are not persisted as
+	// the subject is used once
+	s, err := c.controlPubSub.AsyncSubscribe(ctx, replyTopic(event.CorrelationId))
+	if err != nil {
+		return nil, err
+	}
+	replies := c.awaitReplies(ctx, s, target, 1, timeout)
+
+	messagesSent.WithLabelValues(event.Name).Inc()
+	err = c.pubSub.Publish(ctx, pubsub.PublishRequest{Channel: channel, Data: eventBytes})
+	if err != nil {
+		return nil, err
+	}
+
+	r := <-replies
+	if len(r) == 0 {
+		pErr := perror.Newf(perror.DeadlineExceeded, "no reply from client in %s", timeout)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	return r[0], nil
+}
+
+func (c *Service) validateSendRequestToClientRequest(req SendRequestToClientRequest) error {
+	if req.clientID == "" {
+		return fmt.Errorf("client ID is empty")
+	}
+	if req.event == nil {
+		return fmt.Errorf("event is empty")
+	}
+	if req.event.GetName() == "" {
+		return fmt.Errorf("event name is empty")
+	}
+	return nil
+}
+
+// IsReply checks if an event sent by a client is the reply to a request
+func (c *Service) IsReply(event *pushv1.Event) bool {
+	return event.GetCorrelationId() != ""
+}
+
+// PublishReply publishes the reply of the client of the session to the node waiting on the request, along
+// with the client and device ids so that the node only accepts replies from the target of the request
+func (c *Service) PublishReply(ctx context.Context, session *Session, event *pushv1.Event) error {
+	if event.GetCorrelationId() == "" {
+		return perror.New(perror.InvalidArgument, "correlation ID is empty")
+	}
+	eventBytes, err := proto.Marshal(&pushv1.UpstreamEvent{
+		ClientId:     session.ClientID,
+		DeviceId:     session.DeviceID(),
+		ChannelEvent: &pushv1.ChannelEvent{Event: event},
+	})
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to marshall proto")
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return c.controlPubSub.Publish(ctx, pubsub.PublishRequest{Channel: replyTopic(event.GetCorrelationId()), Data: eventBytes})
+}
+
+// isReplyFrom checks if the reply is sent by the target of the request, any device of the client may reply
+// if the request was sent to the client
+func isReplyFrom(reply *pushv1.UpstreamEvent, target Recipient) bool {
+	if reply.GetClientId() != target.ClientID {
+		return false
 	}
+	return target.DeviceID == "" || reply.GetDeviceId() == target.DeviceID
+}
+
+// replyTopic receives the reply to the request with the correlation id
+func replyTopic(correlationID string) string {
+	return fmt.Sprintf("%s#%s", correlationID, "reply")
 }
 
-// PublishToClient publishes to the client
-func (c *Service) PublishToClient(ctx context.Context, req SendEventToClientChannelRequest) error {
+// PublishToClient publishes to the client, returns true if the event is a duplicate which was not sent again
+func (c *Service) PublishToClient(ctx context.Context, req SendEventToClientChannelRequest) (bool, error) {
 	logger.Ctx(ctx).Infow("publishing to client")
 
 	err := c.validateSendEventToClientChannelRequest(req)
 	if err != nil {
-		return perror.New(perror.InvalidArgument, err.Error())
+		return false, perror.New(perror.InvalidArgument, err.Error())
 	}
 
-	publishReq := pubsub.PublishRequest{Channel: req.clientID, Data: req.event}
+	if !c.claimIdempotencyKey(ctx, req.clientID, req.idempotencyKey) {
+		logger.Ctx(ctx).Infow("skipping duplicate event", "idempotencyKey", req.idempotencyKey)
+		return true, nil
+	}
 
 	messagesSent.WithLabelValues(req.eventName).Inc()
 
-	return c.pubSub.Publish(ctx, publishReq)
+	// events for clients without a live channel are kept in inbox till they connect
+	if c.config.Inbox.Enabled && !c.isClientOnline(ctx, req.clientID) {
+		logger.Ctx(ctx).Debugw("client offline, storing event in inbox")
+		err = c.inbox.Push(ctx, req.clientID, req.event)
+	} else {
+		err = c.pubSub.Publish(ctx, pubsub.PublishRequest{Channel: req.clientID, Data: req.event, ID: req.idempotencyKey})
+	}
+	if err != nil {
+		c.releaseIdempotencyKey(ctx, req.clientID, req.idempotencyKey)
+	}
+	return false, err
 }
 
 func (c *Service) validateSendEventToClientChannelRequest(req SendEventToClientChannelRequest) error {
@@ -210,23 +569,110 @@ func (c *Service) validateSendEventToClientChannelRequest(req SendEventToClientC
 	return nil
 }
 
-// PublishToClientWithDevice publishes to the client with device
-func (c *Service) PublishToClientWithDevice(ctx context.Context, req SendEventToClientDeviceChannelRequest) error {
+// PublishToClients publishes an event to multiple clients in bulk and returns the status of each recipient
+func (c *Service) PublishToClients(ctx context.Context, req SendEventToClientsRequest) ([]RecipientStatus, error) {
+	logger.Ctx(ctx).Infow("publishing to clients", "recipients", len(req.recipients))
+
+	err := c.validateSendEventToClientsRequest(req)
+	if err != nil {
+		return nil, perror.New(perror.InvalidArgument, err.Error())
+	}
+
+	statuses := make([]RecipientStatus, len(req.recipients))
+	var publishReqList []pubsub.PublishRequest
+	// index of the recipient for each publish request
+	var published []int
+	for i, recipient := range req.recipients {
+		statuses[i].Recipient = recipient
+		channel, err := c.getRecipientChannel(recipient)
+		if err != nil {
+			statuses[i].Err = err
+			continue
+		}
+		if !c.claimIdempotencyKey(ctx, channel, req.idempotencyKey) {
+			statuses[i].Duplicate = true
+			continue
+		}
+		messagesSent.WithLabelValues(req.eventName).Inc()
+		if recipient.DeviceID == "" && c.config.Inbox.Enabled && !c.isClientOnline(ctx, recipient.ClientID) {
+			statuses[i].Err = c.inbox.Push(ctx, recipient.ClientID, req.event)
+			if statuses[i].Err != nil {
+				c.releaseIdempotencyKey(ctx, channel, req.idempotencyKey)
+			}
+			continue
+		}
+		publishReqList = append(publishReqList, pubsub.PublishRequest{Channel: channel, Data: req.event, ID: req.idempotencyKey})
+		published = append(published, i)
+	}
+
+	if len(publishReqList) == 0 {
+		return statuses, nil
+	}
+	err = c.pubSub.PublishBulk(ctx, publishReqList)
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in publishing to clients", "error", err.Error())
+		for j, i := range published {
+			statuses[i].Err = err
+			c.releaseIdempotencyKey(ctx, publishReqList[j].Channel, req.idempotencyKey)
+		}
+	}
+	return statuses, nil
+}
+
+func (c *Service) validateSendEventToClientsRequest(req SendEventToClientsRequest) error {
+	if len(req.recipients) == 0 {
+		return fmt.Errorf("recipients are empty")
+	}
+	if req.event == nil {
+		return fmt.Errorf("event is empty")
+	}
+	if req.eventName == "" {
+		return fmt.Errorf("event name is empty")
+	}
+	return nil
+}
+
+// getRecipientChannel returns the channel to publish to for a recipient
+func (c *Service) getRecipientChannel(recipient Recipient) (string, error) {
+	if recipient.ClientID == "" {
+		return "", perror.New(perror.InvalidArgument, "client ID is empty")
+	}
+	if recipient.DeviceID == "" {
+		return recipient.ClientID, nil
+	}
+	if !c.config.EnableDeviceSupport {
+		return "", perror.New(perror.FailedPrecondition, "device support disabled")
+	}
+	return fmt.Sprintf("%s--%s", recipient.ClientID, recipient.DeviceID), nil
+}
+
+// PublishToClientWithDevice publishes to the client with device, returns true if the event is a duplicate which was not sent again
+func (c *Service) PublishToClientWithDevice(ctx context.Context, req SendEventToClientDeviceChannelRequest) (bool, error) {
 	logger.Ctx(ctx).Infow("publishing to client with device")
 	if !c.config.EnableDeviceSupport {
-		return perror.New(perror.FailedPrecondition, "device support disabled")
+		return false, perror.New(perror.FailedPrecondition, "device support disabled")
 	}
 
 	err := c.validateSendEventToClientDeviceChannelRequest(req)
 	if err != nil {
-		return perror.New(perror.InvalidArgument, err.Error())
+		return false, perror.New(perror.InvalidArgument, err.Error())
+	}
+
+	channel := fmt.Sprintf("%s--%s", req.clientID, req.deviceID)
+	if !c.claimIdempotencyKey(ctx, channel, req.idempotencyKey) {
+		logger.Ctx(ctx).Infow("skipping duplicate event", "idempotencyKey", req.idempotencyKey)
+		return true, nil
 	}
 
-	publishReq := pubsub.PublishRequest{Channel: fmt.Sprintf("%s--%s", req.clientID, req.deviceID), Data: req.event}
+	publishReq := pubsub.PublishRequest{Channel: channel, Data: req.event, ID: req.idempotencyKey}
 
 	messagesSent.WithLabelValues(req.eventName).Inc()
 
-	return c.pubSub.Publish(ctx, publishReq)
+	err = c.pubSub.Publish(ctx, publishReq)
+	if err != nil {
+		c.releaseIdempotencyKey(ctx, channel, req.idempotencyKey)
+	}
+	return false, err
 }
 
 func (c *Service) validateSendEventToClientDeviceChannelRequest(req SendEventToClientDeviceChannelRequest) error {
@@ -245,27 +691,42 @@ func (c *Service) validateSendEventToClientDeviceChannelRequest(req SendEventToC
 	return nil
 }
 
-// PublishToTopic publishes to the topic
-func (c *Service) PublishToTopic(ctx context.Context, req SendEventToTopicRequest) error {
+// PublishToTopic publishes to the topic, returns true if the event is a duplicate which was not sent again
+func (c *Service) PublishToTopic(ctx context.Context, req SendEventToTopicRequest) (bool, error) {
 	// TODO: add device id support
 	logger.Ctx(ctx).Infow("publishing to Topic", "Topic", req.Topic)
 
 	err := c.validateSendEventToTopicRequest(req)
 	if err != nil {
-		return perror.New(perror.InvalidArgument, err.Error())
+		return false, perror.New(perror.InvalidArgument, err.Error())
+	}
+
+	if !c.claimIdempotencyKey(ctx, req.Topic, req.IdempotencyKey) {
+		logger.Ctx(ctx).Infow("skipping duplicate event", "idempotencyKey", req.IdempotencyKey)
+		return true, nil
 	}
 
-	publishReq := pubsub.PublishRequest{Channel: req.Topic, Data: req.Event}
+	publishReq := pubsub.PublishRequest{Channel: req.Topic, Data: req.Event, ID: req.IdempotencyKey}
 
 	messagesSent.WithLabelValues(req.EventName).Inc()
 
-	return c.pubSub.Publish(ctx, publishReq)
+	err = c.pubSub.Publish(ctx, publishReq)
+	if err != nil {
+		c.releaseIdempotencyKey(ctx, req.Topic, req.IdempotencyKey)
+	}
+	return false, err
 }
 
 func (c *Service) validateSendEventToTopicRequest(req SendEventToTopicRequest) error {
 	if req.Topic == "" {
 		return perror.New(perror.InvalidArgument, "Topic is empty")
 	}
+	if broker.IsWildcard(req.Topic) {
+		return perror.New(perror.InvalidArgument, "Topic must not have wildcards")
+	}
+	if c.isReservedTopic(req.Topic) {
+		return perror.New(perror.InvalidArgument, "Topic is reserved for broadcast")
+	}
 	if req.Event == nil {
 		return perror.New(perror.InvalidArgument, "Event is empty")
 	}
@@ -286,94 +747,956 @@ func (c *Service) PublishToTopics(ctx context.Context, req SendEventToTopicsRequ
 		if err != nil {
 			return perror.New(perror.InvalidArgument, err.Error())
 		}
-		publishReq := pubsub.PublishRequest{Data: v.Event, Channel: v.Topic}
+	}
+
+	var published []SendEventToTopicRequest
+	for _, v := range req.requests {
+		// duplicates are skipped, the rest of the events are sent
+		if !c.claimIdempotencyKey(ctx, v.Topic, v.IdempotencyKey) {
+			logger.Ctx(ctx).Infow("skipping duplicate event", "topic", v.Topic, "idempotencyKey", v.IdempotencyKey)
+			continue
+		}
+		publishReq := pubsub.PublishRequest{Data: v.Event, Channel: v.Topic, ID: v.IdempotencyKey}
 		publishReqList = append(publishReqList, publishReq)
+		published = append(published, v)
 		messagesSent.WithLabelValues(v.EventName).Inc()
 	}
+	if len(publishReqList) == 0 {
+		return nil
+	}
 
-	return c.pubSub.PublishBulk(ctx, publishReqList)
+	err := c.pubSub.PublishBulk(ctx, publishReqList)
+	if err != nil {
+		for _, v := range published {
+			c.releaseIdempotencyKey(ctx, v.Topic, v.IdempotencyKey)
+		}
+	}
+	return err
 }
 
-// AsyncClientSubscribe to the client
-func (c *Service) AsyncClientSubscribe(ctx context.Context, clientID string, device *Device) (*subscription.Subscription, error) {
-	logger.Ctx(ctx).Infow("subscribing to client", "clientID", clientID)
-	var clientSubscription *subscription.Subscription
-	var err error
-
-	if clientID == "" {
-		return nil, perror.New(perror.InvalidArgument, "client ID is empty")
+// Broadcast publishes an event to every connected client on all nodes, returns true if the event
+// is a duplicate which was not sent again
+func (c *Service) Broadcast(ctx context.Context, req BroadcastEventRequest) (bool, error) {
+	logger.Ctx(ctx).Infow("broadcasting event")
+	if !c.isBroadcastEnabled() {
+		return false, perror.New(perror.FailedPrecondition, "broadcast disabled")
+	}
+	if req.broadcast == nil {
+		return false, perror.New(perror.InvalidArgument, "event is empty")
+	}
+	if req.eventName == "" {
+		return false, perror.New(perror.InvalidArgument, "event name is empty")
+	}
 
+	if !c.claimIdempotencyKey(ctx, c.config.BroadcastTopic, req.idempotencyKey) {
+		logger.Ctx(ctx).Infow("skipping duplicate event", "idempotencyKey", req.idempotencyKey)
+		return true, nil
 	}
 
-	clientSubscription, err = c.pubSub.AsyncSubscribe(ctx, clientID)
+	messagesSent.WithLabelValues(req.eventName).Inc()
+
+	err := c.pubSub.Publish(ctx, pubsub.PublishRequest{Channel: c.config.BroadcastTopic, Data: req.broadcast})
 	if err != nil {
-		return nil, err
+		c.releaseIdempotencyKey(ctx, c.config.BroadcastTopic, req.idempotencyKey)
 	}
+	return false, err
+}
 
-	if c.config.EnableDeviceSupport && device != nil {
-		err = c.pubSub.AddSubscription(ctx, fmt.Sprintf("%s--%s", clientID, device.ID), clientSubscription)
-		if err != nil {
-			return nil, err
-		}
-		v, err := json.Marshal(device.Attributes)
-		err = c.kv.Store(ctx, clientID, device.ID, string(v))
-		if err != nil {
-			logger.Ctx(ctx).Errorf("error in storing device details %+v", err)
-		}
+// IsBroadcastTopic checks if the topic is the broadcast topic
+func (c *Service) IsBroadcastTopic(topic string) bool {
+	return c.isBroadcastEnabled() && topic == c.config.BroadcastTopic
+}
+
+// GetBroadcastEvent returns the event of a broadcast, nil if the device doesn't match the attribute filter
+func (c *Service) GetBroadcastEvent(ctx context.Context, data []byte, device *Device) (*pushv1.Event, error) {
+	broadcast := &pushv1.BroadcastEventRequest{}
+	err := proto.Unmarshal(data, broadcast)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to unmarshal broadcast %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	var attributes map[string]string
+	if device != nil {
+		attributes = device.Attributes
+	}
+	if !matchesAttributeFilter(broadcast.GetAttributeFilter(), attributes) {
+		return nil, nil
 	}
+	return broadcast.GetEvent(), nil
+}
 
-	if c.config.SendTestPayload {
-		go c.triggerTestPayloadToClient(ctx, clientID)
-		if device != nil {
-			go c.triggerTestPayloadToClientWithDevice(ctx, clientID, device.ID)
-		}
+// isBroadcastEnabled checks if channels are subscribed to the broadcast topic, a topic shared by all
+// channels is consumed by only one of them when the broker persists events, so broadcast needs pubsub
+func (c *Service) isBroadcastEnabled() bool {
+	return c.config.BroadcastTopic != "" && !c.config.Broker.Persistence
+}
+
+// isReservedTopic checks if the topic is, or the pattern matches, the broadcast topic, which
+// channels are subscribed to by the node and only Broadcast publishes to
+func (c *Service) isReservedTopic(topic string) bool {
+	return c.config.BroadcastTopic != "" && broker.MatchSubject(topic, c.config.BroadcastTopic)
+}
+
+// Sessions returns the sessions connected to this node
+func (c *Service) Sessions() *SessionRegistry {
+	return c.sessions
+}
+
+// PublishUpstream routes an event sent by the client of the session to the backend
+func (c *Service) PublishUpstream(ctx context.Context, session *Session, channelEvent *pushv1.ChannelEvent) error {
+	if !c.config.Upstream.Enabled {
+		return perror.New(perror.FailedPrecondition, "upstream routing disabled")
 	}
+	if channelEvent.GetEvent().GetName() == "" {
+		return perror.New(perror.InvalidArgument, "event name is empty")
+	}
+	logger.Ctx(ctx).Debugw("routing event upstream", "eventName", channelEvent.GetEvent().GetName())
 
-	connectedClients.Inc()
-	c.sessionStartTime = time.Now()
-	return clientSubscription, nil
+	return c.upstream.Route(ctx, &pushv1.UpstreamEvent{
+		ClientId:     session.ClientID,
+		DeviceId:     session.DeviceID(),
+		ChannelEvent: channelEvent,
+		ReceivedAt:   timestamppb.Now(),
+	})
 }
 
-// TopicSubscribe to the topic
-func (c *Service) TopicSubscribe(ctx context.Context, topic string, clientSubscription *subscription.Subscription) error {
-	logger.Ctx(ctx).Infow("subscribing", "topic", topic)
-	err := c.pubSub.AddSubscription(ctx, topic, clientSubscription)
+// DisconnectClient sends a disconnect control event to the live channels of the client, or a device of the client,
+// so that the nodes holding the channels close them. Channels connected after the request are not closed.
+func (c *Service) DisconnectClient(ctx context.Context, req DisconnectClientRequest) error {
+	logger.Ctx(ctx).Infow("disconnecting client", "reason", req.reason)
+	_, err := c.getRecipientChannel(Recipient{ClientID: req.clientID, DeviceID: req.deviceID})
 	if err != nil {
 		return err
 	}
-	return nil
-}
-
-// TopicUnsubscribe to unsubscribe from a topic
-func (c *Service) TopicUnsubscribe(ctx context.Context, topic string, clientSubscription *subscription.Subscription) error {
-	logger.Ctx(ctx).Debugw("un-subscribing", "topic", topic)
-	err := c.pubSub.RemoveSubscription(ctx, topic, clientSubscription)
+	connections, err := c.liveConnections(ctx, req.clientID)
 	if err != nil {
 		return err
 	}
-	return nil
-}
-
-// ClientUnsubscribe unsubscribes a client
-func (c *Service) ClientUnsubscribe(ctx context.Context, clientID string, subscription *subscription.Subscription, device *Device) error {
-	if c.config.EnableDeviceSupport {
-		err := c.kv.Delete(ctx, clientID, device.ID)
-		if err != nil {
-			logger.Ctx(ctx).Errorf("error in deleting device details %+v", err.Error())
+	var channelIDs []string
+	for id, record := range connections {
+		if req.deviceID == "" || record.DeviceID == req.deviceID {
+			channelIDs = append(channelIDs, id)
 		}
 	}
-	connectedClients.Dec()
-	sessionDuration.Observe(time.Since(c.sessionStartTime).Seconds())
-	return c.pubSub.Unsubscribe(ctx, subscription)
+	if len(channelIDs) == 0 {
+		return nil
+	}
+	sort.Strings(channelIDs)
+	return c.publishControlEvent(ctx, req.clientID, &pushv1.ControlEvent{
+		Control: &pushv1.ControlEvent_Disconnect{Disconnect: &pushv1.DisconnectControl{Reason: req.reason, ChannelIds: channelIDs}},
+	})
 }
 
-func (c *Service) triggerTestPayloadToClient(ctx context.Context, clientID string) {
-	for {
-		select {
+// publishControlEvent publishes the control event, wrapped in an event, to the control subject of the client
+func (c *Service) publishControlEvent(ctx context.Context, clientID string, controlEvent *pushv1.ControlEvent) error {
+	controlEvent.IssuedAt = timestamppb.Now()
+	data, err := anypb.New(controlEvent)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to create control event %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	event, err := proto.Marshal(&pushv1.Event{Name: ControlEventName, FormatType: pushv1.Event_TYPE_PROTO, Data: data})
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to marshal control event %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return c.controlPubSub.Publish(ctx, pubsub.PublishRequest{Channel: controlSubject(clientID), Data: event})
+}
+
+// controlSubject carries the control events of the channels of a client
+func controlSubject(clientID string) string {
+	return fmt.Sprintf("%s#%s", clientID, "control")
+}
+
+// SubscribeClientToTopic sends a subscribe control event to the channels of the client, or a device of the client
+func (c *Service) SubscribeClientToTopic(ctx context.Context, req SubscribeClientToTopicRequest) error {
+	logger.Ctx(ctx).Infow("subscribing client to topic", "topic", req.topic)
+	err := c.validateTopicControl(ctx, req.clientID, req.deviceID, req.topic)
+	if err != nil {
+		return err
+	}
+	live, err := c.hasLiveChannel(ctx, req.clientID, req.deviceID)
+	if err != nil {
+		return err
+	}
+	if !live {
+		return c.persistOfflineTopic(ctx, req.clientID, req.deviceID, req.topic)
+	}
+	return c.publishControlEvent(ctx, req.clientID, &pushv1.ControlEvent{
+		DeviceId: req.deviceID,
+		Control:  &pushv1.ControlEvent_Subscribe{Subscribe: &pushv1.TopicControl{Topic: req.topic}},
+	})
+}
+
+// UnsubscribeClientFromTopic sends an unsubscribe control event to the channels of the client, or a device of the client
+func (c *Service) UnsubscribeClientFromTopic(ctx context.Context, req UnsubscribeClientFromTopicRequest) error {
+	logger.Ctx(ctx).Infow("unsubscribing client from topic", "topic", req.topic)
+	err := c.validateTopicControl(ctx, req.clientID, req.deviceID, req.topic)
+	if err != nil {
+		return err
+	}
+	live, err := c.hasLiveChannel(ctx, req.clientID, req.deviceID)
+	if err != nil {
+		return err
+	}
+	if !live {
+		// a topic persisted while the client was offline is not restored on connect
+		c.forgetTopic(ctx, req.clientID, offlineDevice(req.deviceID), req.topic)
+		return nil
+	}
+	return c.publishControlEvent(ctx, req.clientID, &pushv1.ControlEvent{
+		DeviceId: req.deviceID,
+		Control:  &pushv1.ControlEvent_Unsubscribe{Unsubscribe: &pushv1.TopicControl{Topic: req.topic}},
+	})
+}
+
+// hasLiveChannel checks if the client, or the device of the client, has a live channel on any node
+func (c *Service) hasLiveChannel(ctx context.Context, clientID string, deviceID string) (bool, error) {
+	connections, err := c.liveConnections(ctx, clientID)
+	if err != nil {
+		return false, err
+	}
+	for _, record := range connections {
+		if deviceID == "" || record.DeviceID == deviceID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// persistOfflineTopic persists the topic to subscribe the client, or the device of the client, to on connect
+// as no channel receives the control event. Topics of a device are persisted by device with device support,
+// so the device is required.
+func (c *Service) persistOfflineTopic(ctx context.Context, clientID string, deviceID string, topic string) error {
+	if !c.config.PersistTopicSubscriptions {
+		pErr := perror.Newf(perror.FailedPrecondition, "client %s has no live channel to subscribe", clientID)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	if c.config.EnableDeviceSupport && deviceID == "" {
+		pErr := perror.Newf(perror.FailedPrecondition, "client %s has no live channel, device id is required to persist the topic", clientID)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	// unlike on request of a channel, the subscription is lost if it is not persisted
+	return c.kv.Store(ctx, c.topicsKey(clientID, offlineDevice(deviceID)), topic, topicOriginServer)
+}
+
+// offlineDevice returns the device with the id for the persisted topics of a device without a channel
+func offlineDevice(deviceID string) *Device {
+	if deviceID == "" {
+		return nil
+	}
+	return &Device{ID: deviceID}
+}
+
+// validateTopicControl validates the topic and the recipient of the topic control event
+func (c *Service) validateTopicControl(ctx context.Context, clientID string, deviceID string, topic string) error {
+	if topic == "" {
+		return perror.New(perror.InvalidArgument, "topic is empty")
+	}
+	err := c.validateTopicPattern(ctx, topic)
+	if err != nil {
+		return err
+	}
+	_, err = c.getRecipientChannel(Recipient{ClientID: clientID, DeviceID: deviceID})
+	return err
+}
+
+// GetControlEvent returns the control event carried by the event, nil if it is not a control event
+func (c *Service) GetControlEvent(ctx context.Context, event *pushv1.Event) (*pushv1.ControlEvent, error) {
+	if event.GetName() != ControlEventName {
+		return nil, nil
+	}
+	controlEvent := &pushv1.ControlEvent{}
+	err := event.GetData().UnmarshalTo(controlEvent)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to unmarshal control event %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	return controlEvent, nil
+}
+
+// IsControlEventTarget checks if the channel of the session is to act on the control event
+func (c *Service) IsControlEventTarget(controlEvent *pushv1.ControlEvent, session *Session) bool {
+	if controlEvent.GetDeviceId() != "" && controlEvent.GetDeviceId() != session.DeviceID() {
+		return false
+	}
+	channelIDs := controlEvent.GetDisconnect().GetChannelIds()
+	return len(channelIDs) == 0 || slices.Contains(channelIDs, session.ID)
+}
+
+// AsyncClientSubscribe to the client, if the cursor is set only events after the cursor are received.
+// The channel is also subscribed to the persisted topics and the topics of the options.
+// Returns the session of the channel, which is registered till ClientUnsubscribe.
+func (c *Service) AsyncClientSubscribe(ctx context.Context, clientID string, device *Device, options ChannelOptions) (*Session, ConnectResult, error) {
+	logger.Ctx(ctx).Infow("subscribing to client", "clientID", clientID)
+	cursor := options.Cursor
+	var clientSubscription *subscription.Subscription
+	var result ConnectResult
+	var err error
+
+	if clientID == "" {
+		return nil, result, perror.New(perror.InvalidArgument, "client ID is empty")
+
+	}
+	if len(options.Topics) > maxConnectTopics {
+		return nil, result, perror.Newf(perror.InvalidArgument, "at most %d topics are allowed on connect", maxConnectTopics)
+	}
+	err = c.enforceChannelLimits(ctx, clientID, device)
+	if err != nil {
+		return nil, result, err
+	}
+
+	if cursor != "" {
+		logger.Ctx(ctx).Infow("resuming client channel", "cursor", cursor)
+		clientSubscription, err = c.pubSub.AsyncSubscribeFrom(ctx, clientID, cursor)
+		if err == nil && c.isBroadcastEnabled() {
+			err = c.pubSub.AddSubscription(ctx, c.config.BroadcastTopic, clientSubscription)
+		}
+	} else {
+		subjects := []string{clientID}
+		if c.isBroadcastEnabled() {
+			subjects = append(subjects, c.config.BroadcastTopic)
+		}
+		clientSubscription, err = c.pubSub.AsyncSubscribe(ctx, subjects...)
+	}
+	if err != nil {
+		return nil, result, err
+	}
+	session := newSession(clientID, device, clientSubscription)
+	session.ControlSubscription, err = c.controlPubSub.AsyncSubscribe(ctx, controlSubject(clientID))
+	if err != nil {
+		_ = c.pubSub.Unsubscribe(ctx, clientSubscription)
+		return nil, result, err
+	}
+	if c.config.Ack.Enabled {
+		session.AckTracker = ack.NewTracker(c.config.Ack)
+	}
+
+	err = c.storeConnection(ctx, session)
+	if err != nil {
+		logger.Ctx(ctx).Errorf("error in storing connection %+v", err)
+	}
+	session.startTask(ctx, "refresh-connection", func(ctx context.Context) {
+		c.refreshConnection(ctx, session)
+	})
+
+	if c.config.EnableDeviceSupport && device != nil {
+		err = c.pubSub.AddSubscription(ctx, fmt.Sprintf("%s--%s", clientID, device.ID), clientSubscription)
+		if err != nil {
+			c.abortSubscribe(ctx, session)
+			return nil, result, err
+		}
+		device.LoggedInAt = time.Now()
+		err = c.storeDevice(ctx, clientID, device)
+		if err != nil {
+			logger.Ctx(ctx).Errorf("error in storing device details %+v", err)
+		}
+		session.startTask(ctx, "refresh-device", func(ctx context.Context) {
+			c.refreshDevice(ctx, clientID, device)
+		})
+	}
+
+	if c.config.SendTestPayload {
+		session.startTask(ctx, "test-payload", func(ctx context.Context) {
+			c.triggerTestPayloadToClient(ctx, clientID)
+		})
+		if device != nil {
+			session.startTask(ctx, "test-payload-device", func(ctx context.Context) {
+				c.triggerTestPayloadToClientWithDevice(ctx, clientID, device.ID)
+			})
+		}
+	}
+
+	// replay only after the connection is stored, so that no new event lands in inbox
+	if c.config.Inbox.Enabled {
+		session.startTask(ctx, "drain-inbox", func(ctx context.Context) {
+			c.keepInboxDrained(ctx, clientID, clientSubscription)
+		})
+	}
+
+	if c.config.PersistTopicSubscriptions {
+		result.RestoredTopics = c.restoreTopics(ctx, session, options.TopicRestore)
+	}
+	result.Topics = c.subscribeConnectTopics(ctx, session, options.Topics, result.RestoredTopics)
+
+	if c.config.SingleActiveDevice && c.config.EnableDeviceSupport && session.DeviceID() != "" {
+		c.replaceOtherDevices(ctx, session)
+	}
+
+	c.sessions.Add(session)
+	connectedClients.Inc()
+	return session, result, nil
+}
+
+// abortSubscribe releases the session of a channel which failed to subscribe before it was registered
+func (c *Service) abortSubscribe(ctx context.Context, session *Session) {
+	session.close()
+	err := c.kv.Delete(ctx, connectionsKey(session.ClientID), session.ID)
+	if err != nil {
+		logger.Ctx(ctx).Errorf("error in deleting connection %+v", err.Error())
+	}
+	err = c.controlPubSub.Unsubscribe(ctx, session.ControlSubscription)
+	if err != nil {
+		logger.Ctx(ctx).Errorf("error in unsubscribing control subscription %+v", err.Error())
+	}
+	err = c.pubSub.Unsubscribe(ctx, session.Subscription)
+	if err != nil {
+		logger.Ctx(ctx).Errorf("error in unsubscribing client subscription %+v", err.Error())
+	}
+}
+
+// replaceOtherDevices closes the live channels of the other devices of the client, on any node, as the device
+// of the session is the only active device of the client. They are sent a SESSION_REPLACED event
+// with the new device as data before their channels are closed.
+func (c *Service) replaceOtherDevices(ctx context.Context, session *Session) {
+	connections, err := c.liveConnections(ctx, session.ClientID)
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in replacing other devices", "error", err.Error())
+		return
+	}
+	var channelIDs []string
+	for id, record := range connections {
+		if record.DeviceID != session.DeviceID() {
+			channelIDs = append(channelIDs, id)
+		}
+	}
+	if len(channelIDs) == 0 {
+		return
+	}
+	sort.Strings(channelIDs)
+	data, err := anypb.New(session.Device.ToProto())
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in creating session replaced event", "error", err.Error())
+		return
+	}
+	err = c.publishControlEvent(ctx, session.ClientID, &pushv1.ControlEvent{
+		Control: &pushv1.ControlEvent_Disconnect{Disconnect: &pushv1.DisconnectControl{
+			Reason:     "session replaced by another device",
+			ChannelIds: channelIDs,
+			Event:      &pushv1.Event{Name: SessionReplacedEventName, FormatType: pushv1.Event_TYPE_PROTO, Data: data},
+		}},
+	})
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in replacing other devices", "error", err.Error())
+		return
+	}
+	sessionsReplaced.Inc()
+}
+
+// subscribeConnectTopics subscribes the channel to the topics passed on connect, which are subscribed to
+// before any event is received on the channel. A topic already restored is not subscribed to again.
+func (c *Service) subscribeConnectTopics(ctx context.Context, session *Session, topics []string, restoredTopics []string) []TopicStatus {
+	subscribed := make(map[string]bool)
+	for _, topic := range restoredTopics {
+		subscribed[topic] = true
+	}
+	var statuses []TopicStatus
+	for _, topic := range topics {
+		if subscribed[topic] {
+			statuses = append(statuses, TopicStatus{Topic: topic})
+			continue
+		}
+		err := c.TopicSubscribe(ctx, session, topic)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error in subscribing to topic on connect", "topic", topic, "error", err.Error())
+		} else {
+			subscribed[topic] = true
+		}
+		statuses = append(statuses, TopicStatus{Topic: topic, Err: err})
+	}
+	return statuses
+}
+
+// restoreTopics subscribes the channel to the persisted topics of the client, or the device of the client,
+// and returns the topics subscribed to. Topics subscribed to by the client are authorized again.
+func (c *Service) restoreTopics(ctx context.Context, session *Session, mode TopicRestoreMode) []string {
+	key := c.topicsKey(session.ClientID, session.Device)
+	switch mode {
+	case TopicRestoreSkip:
+		return nil
+	case TopicRestoreReset:
+		err := c.kv.DeleteKey(ctx, key)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error in resetting topics", "error", err.Error())
+		}
+		return nil
+	}
+	v, err := c.kv.Load(ctx, key)
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in loading topics", "error", err.Error())
+		return nil
+	}
+	var topics []string
+	for topic, origin := range v {
+		err := c.validateTopicPattern(ctx, topic)
+		if err == nil && origin != topicOriginServer {
+			err = c.authorizer.Authorize(ctx, authz.Request{ClientID: session.ClientID, DeviceID: session.DeviceID(), Topic: topic})
+		}
+		if err == nil {
+			err = c.subscribeTopic(ctx, session, topic)
+		}
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error in restoring topic", "topic", topic, "error", err.Error())
+			continue
+		}
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// persistTopic stores the topic to subscribe to again on reconnect, origin is who subscribed to it
+func (c *Service) persistTopic(ctx context.Context, clientID string, device *Device, topic string, origin string) {
+	if !c.config.PersistTopicSubscriptions {
+		return
+	}
+	err := c.kv.Store(ctx, c.topicsKey(clientID, device), topic, origin)
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in persisting topic", "topic", topic, "error", err.Error())
+	}
+}
+
+// forgetTopic deletes the persisted topic
+func (c *Service) forgetTopic(ctx context.Context, clientID string, device *Device, topic string) {
+	if !c.config.PersistTopicSubscriptions {
+		return
+	}
+	err := c.kv.Delete(ctx, c.topicsKey(clientID, device), topic)
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in deleting persisted topic", "topic", topic, "error", err.Error())
+	}
+}
+
+// topicsKey holds the persisted topics of a client, or of a device of the client with device support,
+// with topic as field and who subscribed to it as value
+func (c *Service) topicsKey(clientID string, device *Device) string {
+	if c.config.EnableDeviceSupport && device != nil {
+		return fmt.Sprintf("%s--%s#%s", clientID, device.ID, "topics")
+	}
+	return fmt.Sprintf("%s#%s", clientID, "topics")
+}
+
+// keepInboxDrained replays the inbox on connect and again at every connection refresh till the channel is
+// closed, as an event for a client checked offline just before the connection was stored, or while it
+// could not be stored, lands in inbox after the first replay
+func (c *Service) keepInboxDrained(ctx context.Context, clientID string, clientSubscription *subscription.Subscription) {
+	c.drainInbox(ctx, clientID, clientSubscription)
+	ticker := time.NewTicker(c.deviceTTL() / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.drainInbox(ctx, clientID, clientSubscription)
+		}
+	}
+}
+
+// drainInbox replays events stored while the client was offline on the new subscription
+func (c *Service) drainInbox(ctx context.Context, clientID string, clientSubscription *subscription.Subscription) {
+	events, err := c.inbox.Drain(ctx, clientID)
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in draining inbox", "error", err.Error())
+		return
+	}
+	for i, event := range events {
+		select {
+		case clientSubscription.TopicEventChan <- broker.TopicEvent{Event: event, Topic: clientID}:
+		case <-ctx.Done():
+			// channel closed before the replay finished, keep the rest for the next connect
+			for _, e := range events[i:] {
+				_ = c.inbox.Push(context.WithoutCancel(ctx), clientID, e)
+			}
+			return
+		}
+	}
+	logger.Ctx(ctx).Debugw("replayed events from inbox", "count", len(events))
+}
+
+// claimIdempotencyKey returns false if an event with the idempotency key was already sent to the channel
+// within the idempotency window, events without an idempotency key are never duplicates
+func (c *Service) claimIdempotencyKey(ctx context.Context, channel string, key string) bool {
+	if key == "" {
+		return true
+	}
+	window := defaultIdempotencyWindow
+	if c.config.IdempotencyWindowInSec > 0 {
+		window = time.Duration(c.config.IdempotencyWindowInSec) * time.Second
+	}
+	ok, err := c.kv.StoreIfNotExists(ctx, idempotencyKey(channel, key), window)
+	if err != nil {
+		// send the event rather than risk dropping it
+		logger.Ctx(ctx).Errorw("error in storing idempotency key", "error", err.Error())
+		return true
+	}
+	if !ok {
+		messagesDuplicate.Inc()
+	}
+	return ok
+}
+
+// releaseIdempotencyKey lets a retry through after the event could not be sent
+func (c *Service) releaseIdempotencyKey(ctx context.Context, channel string, key string) {
+	if key == "" {
+		return
+	}
+	err := c.kv.DeleteKey(ctx, idempotencyKey(channel, key))
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in deleting idempotency key", "error", err.Error())
+	}
+}
+
+// idempotencyKey holds the idempotency key of an event sent to a channel
+func idempotencyKey(channel string, key string) string {
+	return fmt.Sprintf("%s#%s#%s", channel, key, "idempotency")
+}
+
+// isClientOnline checks if the client has a live channel on any node
+func (c *Service) isClientOnline(ctx context.Context, clientID string) bool {
+	connections, err := c.liveConnections(ctx, clientID)
+	if err != nil {
+		// consider the client online so that the event goes to the broker
+		return true
+	}
+	return len(connections) > 0
+}
+
+// liveConnections returns the records of the live channels of the client by channel id, the records of
+// the channels of a crashed node are left behind till they are stale
+func (c *Service) liveConnections(ctx context.Context, clientID string) (map[string]connectionRecord, error) {
+	v, err := c.kv.Load(ctx, connectionsKey(clientID))
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in loading connections", "error", err.Error())
+		return nil, err
+	}
+	now := time.Now()
+	connections := make(map[string]connectionRecord)
+	for id, value := range v {
+		var record connectionRecord
+		if json.Unmarshal([]byte(value), &record) == nil && c.isConnectionAlive(record, now) {
+			connections[id] = record
+		}
+	}
+	return connections, nil
+}
+
+// connectionsKey holds live channels of a client, with channel id as field and connection record as value
+func connectionsKey(clientID string) string {
+	return fmt.Sprintf("%s#%s", clientID, "connections")
+}
+
+// TopicSubscribe to the topic on request of the client, if the subscription is authorized
+func (c *Service) TopicSubscribe(ctx context.Context, session *Session, topic string) error {
+	logger.Ctx(ctx).Infow("subscribing", "topic", topic)
+	err := c.validateTopicPattern(ctx, topic)
+	if err != nil {
+		return err
+	}
+	err = c.authorizer.Authorize(ctx, authz.Request{ClientID: session.ClientID, DeviceID: session.DeviceID(), Topic: topic})
+	if err != nil {
+		return err
+	}
+	err = c.subscribeTopic(ctx, session, topic)
+	if err != nil {
+		return err
+	}
+	c.persistTopic(ctx, session.ClientID, session.Device, topic, topicOriginClient)
+	return nil
+}
+
+// ServerTopicSubscribe to the topic on request of the backend, which is not authorized
+func (c *Service) ServerTopicSubscribe(ctx context.Context, session *Session, topic string) error {
+	logger.Ctx(ctx).Infow("subscribing on server request", "topic", topic)
+	err := c.validateTopicPattern(ctx, topic)
+	if err != nil {
+		return err
+	}
+	err = c.subscribeTopic(ctx, session, topic)
+	if err != nil {
+		return err
+	}
+	c.persistTopic(ctx, session.ClientID, session.Device, topic, topicOriginServer)
+	return nil
+}
+
+func (c *Service) subscribeTopic(ctx context.Context, session *Session, topic string) error {
+	if c.isReservedTopic(topic) {
+		pErr := perror.Newf(perror.InvalidArgument, "topic %s is reserved for broadcast", topic)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	err := c.pubSub.AddSubscription(ctx, topic, session.Subscription)
+	if err != nil {
+		return err
+	}
+	// subscribing again is not a join
+	if session.addTopic(topic) {
+		c.joinTopic(ctx, session, topic)
+	}
+	return nil
+}
+
+// TopicUnsubscribe to unsubscribe from a topic
+func (c *Service) TopicUnsubscribe(ctx context.Context, session *Session, topic string) error {
+	logger.Ctx(ctx).Debugw("un-subscribing", "topic", topic)
+	err := c.validateTopicPattern(ctx, topic)
+	if err != nil {
+		return err
+	}
+	err = c.pubSub.RemoveSubscription(ctx, topic, session.Subscription)
+	if err != nil {
+		return err
+	}
+	if session.removeTopic(topic) {
+		c.leaveTopic(ctx, session, topic)
+	}
+	session.stopTask(testPayloadTask(topic))
+	c.forgetTopic(ctx, session.ClientID, session.Device, topic)
+	return nil
+}
+
+// GetTopicPresence returns the clients subscribed to the topic on any node
+func (c *Service) GetTopicPresence(ctx context.Context, req GetTopicPresenceRequest) ([]TopicPresenceMember, error) {
+	logger.Ctx(ctx).Infow("getting topic presence")
+	if !c.config.EnableTopicPresence {
+		return nil, perror.New(perror.FailedPrecondition, "topic presence disabled")
+	}
+	if req.topic == "" {
+		return nil, perror.New(perror.InvalidArgument, "topic required")
+	}
+	channels, err := c.livePresence(ctx, req.topic)
+	if err != nil {
+		return nil, err
+	}
+	// a device with multiple channels is a member once
+	seen := make(map[TopicPresenceMember]bool)
+	members := []TopicPresenceMember{}
+	for _, member := range channels {
+		if seen[member] {
+			continue
+		}
+		seen[member] = true
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].ClientID != members[j].ClientID {
+			return members[i].ClientID < members[j].ClientID
+		}
+		return members[i].DeviceID < members[j].DeviceID
+	})
+	return members, nil
+}
+
+// isPresenceTracked checks if presence is tracked for the topic, patterns are not tracked
+// as events cannot be sent to them
+func (c *Service) isPresenceTracked(topic string) bool {
+	return c.config.EnableTopicPresence && !broker.IsWildcard(topic)
+}
+
+// joinTopic stores the channel as a member of the topic and notifies the subscribers if it is the first
+// channel of the member
+func (c *Service) joinTopic(ctx context.Context, session *Session, topic string) {
+	if !c.isPresenceTracked(topic) {
+		return
+	}
+	member := presenceMember(session)
+	// checked before storing so that the channel is not counted as another channel of the member
+	joined := c.hasOtherPresence(ctx, topic, session.ID, member)
+	err := c.storePresence(ctx, session, topic)
+	if err != nil {
+		logger.Ctx(ctx).Errorf("error in storing presence %+v", err)
+		return
+	}
+	if !joined {
+		c.publishPresenceEvent(ctx, PresenceJoin, member, topic)
+	}
+}
+
+// leaveTopic deletes the channel as a member of the topic and notifies the subscribers if it was the last
+// channel of the member
+func (c *Service) leaveTopic(ctx context.Context, session *Session, topic string) {
+	if !c.isPresenceTracked(topic) {
+		return
+	}
+	err := c.kv.Delete(ctx, presenceKey(topic), session.ID)
+	if err != nil {
+		logger.Ctx(ctx).Errorf("error in deleting presence %+v", err)
+	}
+	member := presenceMember(session)
+	if !c.hasOtherPresence(ctx, topic, session.ID, member) {
+		c.publishPresenceEvent(ctx, PresenceLeave, member, topic)
+	}
+}
+
+// storePresence stores the channel of the session as a member of the topic
+func (c *Service) storePresence(ctx context.Context, session *Session, topic string) error {
+	v, err := json.Marshal(presenceRecord{TopicPresenceMember: presenceMember(session), LastSeenAt: time.Now()})
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to marshal presence record %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return c.kv.Store(ctx, presenceKey(topic), session.ID, string(v))
+}
+
+// refreshPresence keeps the channel of the session a member of its tracked topics, it is refreshed along
+// with the connection record so that the channels of a crashed node stop being members once stale
+func (c *Service) refreshPresence(ctx context.Context, session *Session) {
+	for _, topic := range session.Topics() {
+		if !c.isPresenceTracked(topic) {
+			continue
+		}
+		err := c.storePresence(ctx, session, topic)
+		if err != nil {
+			logger.Ctx(ctx).Errorf("error in refreshing presence %+v", err)
+		}
+	}
+}
+
+// livePresence returns the members of the live channels subscribed to the topic by channel id
+func (c *Service) livePresence(ctx context.Context, topic string) (map[string]TopicPresenceMember, error) {
+	v, err := c.kv.Load(ctx, presenceKey(topic))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	members := make(map[string]TopicPresenceMember)
+	for id, value := range v {
+		var record presenceRecord
+		err := json.Unmarshal([]byte(value), &record)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error unmarshalling presence member", "err", err)
+			continue
+		}
+		if now.Sub(record.LastSeenAt) <= c.deviceTTL() {
+			members[id] = record.TopicPresenceMember
+		}
+	}
+	return members, nil
+}
+
+// hasOtherPresence checks if the member has a live channel other than channelID subscribed to the topic,
+// an error is treated as no other channel so that the event is sent
+func (c *Service) hasOtherPresence(ctx context.Context, topic, channelID string, member TopicPresenceMember) bool {
+	members, err := c.livePresence(ctx, topic)
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in loading presence", "topic", topic, "error", err.Error())
+		return false
+	}
+	for id, m := range members {
+		if id != channelID && m == member {
+			return true
+		}
+	}
+	return false
+}
+
+// leaveAllTopics deletes the channel as a member of every topic it is subscribed to
+func (c *Service) leaveAllTopics(ctx context.Context, session *Session) {
+	for _, topic := range session.Topics() {
+		if session.removeTopic(topic) {
+			c.leaveTopic(ctx, session, topic)
+		}
+	}
+}
+
+func presenceMember(session *Session) TopicPresenceMember {
+	return TopicPresenceMember{ClientID: session.ClientID, DeviceID: session.DeviceID()}
+}
+
+// publishPresenceEvent sends a presence event with the member as data to the subscribers of the topic
+func (c *Service) publishPresenceEvent(ctx context.Context, eventName string, member TopicPresenceMember, topic string) {
+	if !c.config.SendTopicPresenceEvents {
+		return
+	}
+	data, err := anypb.New(member.ToProto())
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in creating presence event", "error", err.Error())
+		return
+	}
+	event, err := proto.Marshal(&pushv1.Event{Name: eventName, FormatType: pushv1.Event_TYPE_PROTO, Data: data})
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in marshalling presence event", "error", err.Error())
+		return
+	}
+	err = c.pubSub.Publish(ctx, pubsub.PublishRequest{Channel: topic, Data: event})
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in publishing presence event", "topic", topic, "error", err.Error())
+		return
+	}
+	messagesSent.WithLabelValues(eventName).Inc()
+}
+
+// presenceKey holds the channels subscribed to a topic, with channel id as field and presence record as value
+func presenceKey(topic string) string {
+	return fmt.Sprintf("%s#%s", topic, "presence")
+}
+
+// validateTopicPattern checks a wildcard topic, persisted topics are consumed by a shared consumer
+// so a pattern would not fan out to every channel
+func (c *Service) validateTopicPattern(ctx context.Context, topic string) error {
+	if !broker.IsWildcard(topic) {
+		return nil
+	}
+	err := broker.ValidateWildcard(topic)
+	if err != nil {
+		pErr := perror.Newf(perror.InvalidArgument, "invalid topic pattern %s: %v", topic, err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	if c.config.Broker.Persistence {
+		pErr := perror.Newf(perror.FailedPrecondition, "wildcard topic %s is not supported with persistence", topic)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return nil
+}
+
+// ClientUnsubscribe closes the session, a session which is already closed is ignored
+func (c *Service) ClientUnsubscribe(ctx context.Context, session *Session) error {
+	if !c.sessions.Remove(session) {
+		return nil
+	}
+	session.close()
+	if c.config.EnableDeviceSupport && session.Device != nil {
+		err := c.kv.Delete(ctx, session.ClientID, session.Device.ID)
+		if err != nil {
+			logger.Ctx(ctx).Errorf("error in deleting device details %+v", err.Error())
+		}
+	}
+	err := c.kv.Delete(ctx, connectionsKey(session.ClientID), session.ID)
+	if err != nil {
+		logger.Ctx(ctx).Errorf("error in deleting connection %+v", err.Error())
+	}
+	c.leaveAllTopics(ctx, session)
+	connectedClients.Dec()
+	duration := time.Since(session.StartedAt)
+	sessionDuration.Observe(duration.Seconds())
+	stats := session.Stats()
+	logger.Ctx(ctx).Infow("session closed", "duration", duration.String(), "eventsSent", stats.EventsSent, "eventsReceived", stats.EventsReceived)
+	if session.ControlSubscription != nil {
+		err = c.controlPubSub.Unsubscribe(ctx, session.ControlSubscription)
+		if err != nil {
+			logger.Ctx(ctx).Errorf("error in unsubscribing control subscription %+v", err.Error())
+		}
+	}
+	return c.pubSub.Unsubscribe(ctx, session.Subscription)
+}
+
+func (c *Service) triggerTestPayloadToClient(ctx context.Context, clientID string) {
+	for {
+		select {
 		case <-ctx.Done():
 			return
 		default:
-			_ = c.PublishToClient(ctx, getDummySendEventToClientRequest(clientID))
+			_, _ = c.PublishToClient(ctx, getDummySendEventToClientRequest(clientID))
 		}
 		time.Sleep(10 * time.Second)
 	}
@@ -385,31 +1708,51 @@ func (c *Service) triggerTestPayloadToClientWithDevice(ctx context.Context, clie
 		case <-ctx.Done():
 			return
 		default:
-			_ = c.PublishToClientWithDevice(ctx, getDummySendEventToClientDeviceRequest(clientID, deviceID))
+			_, _ = c.PublishToClientWithDevice(ctx, getDummySendEventToClientDeviceRequest(clientID, deviceID))
 		}
 		time.Sleep(10 * time.Second)
 	}
 }
 
-// TriggerTestPayloadToTopic triggers test payload to topic
-func (c *Service) TriggerTestPayloadToTopic(ctx context.Context, topic string) {
+// StartTestPayloadToTopic sends test payloads to the topic till the session unsubscribes from it
+func (c *Service) StartTestPayloadToTopic(ctx context.Context, session *Session, topic string) {
+	session.startTask(ctx, testPayloadTask(topic), func(ctx context.Context) {
+		c.triggerTestPayloadToTopic(ctx, topic)
+	})
+}
+
+func testPayloadTask(topic string) string {
+	return "test-payload#" + topic
+}
+
+func (c *Service) triggerTestPayloadToTopic(ctx context.Context, topic string) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
-			_ = c.PublishToTopic(ctx, getDummySendEventToTopicRequest(topic))
+			_, _ = c.PublishToTopic(ctx, getDummySendEventToTopicRequest(topic))
 		}
 		time.Sleep(10 * time.Second)
 	}
 }
 
-// IsDeviceValidationMessage checks if the message is device validation message
-func (c *Service) IsDeviceValidationMessage(eventName string) bool {
-	if eventName == DeviceValidation {
-		return true
+// IsEventExpired checks if the event has expired and must not be delivered
+func (c *Service) IsEventExpired(event *pushv1.Event, now time.Time) bool {
+	if !isExpired(event, now) {
+		return false
 	}
-	return false
+	messagesExpired.WithLabelValues(event.GetName()).Inc()
+	return true
+}
+
+// IsEventFiltered checks if the event received on the topic is filtered out by the filters of the session
+func (c *Service) IsEventFiltered(session *Session, topic string, event *pushv1.Event) bool {
+	if session.Filters.Matches(topic, event.GetName()) {
+		return false
+	}
+	messagesFiltered.WithLabelValues(event.GetName()).Inc()
+	return true
 }
 
 // ConfirmEventReceipt is just used for instrumentation
@@ -0,0 +1,670 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/authz"
+	"github.com/CRED-CLUB/propeller/internal/cluster"
+	"github.com/CRED-CLUB/propeller/internal/config"
+	"github.com/CRED-CLUB/propeller/internal/kv"
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/internal/pubsub"
+	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
+	"github.com/CRED-CLUB/propeller/pkg/broker"
+	redispkg "github.com/CRED-CLUB/propeller/pkg/broker/redis"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakePubSub struct {
+	pubsub.IPubSub
+	published    []pubsub.PublishRequest
+	added        []string
+	unsubscribed []*subscription.Subscription
+	// addErr fails AddSubscription
+	addErr error
+}
+
+func (f *fakePubSub) Publish(ctx context.Context, request pubsub.PublishRequest) error {
+	f.published = append(f.published, request)
+	return nil
+}
+
+func (f *fakePubSub) AddSubscription(ctx context.Context, subject string, subs *subscription.Subscription) error {
+	if f.addErr != nil {
+		return f.addErr
+	}
+	f.added = append(f.added, subs.ID.String()+"/"+subject)
+	return nil
+}
+
+// subscriptionCount returns the number of times the subscription was added to the subject
+func (f *fakePubSub) subscriptionCount(subs *subscription.Subscription, subject string) int {
+	count := 0
+	for _, a := range f.added {
+		if a == subs.ID.String()+"/"+subject {
+			count++
+		}
+	}
+	return count
+}
+
+func (f *fakePubSub) RemoveSubscription(ctx context.Context, subject string, subs *subscription.Subscription) error {
+	return nil
+}
+
+func (f *fakePubSub) AsyncSubscribe(ctx context.Context, subject ...string) (*subscription.Subscription, error) {
+	return newTestSubscription(), nil
+}
+
+func (f *fakePubSub) AsyncSubscribeFrom(ctx context.Context, subject string, cursor string) (*subscription.Subscription, error) {
+	return newTestSubscription(), nil
+}
+
+func (f *fakePubSub) Unsubscribe(ctx context.Context, subs *subscription.Subscription) error {
+	f.unsubscribed = append(f.unsubscribed, subs)
+	return nil
+}
+
+// publishedEventNames returns the names of events published to the topic
+func (f *fakePubSub) publishedEventNames(t *testing.T, topic string) []string {
+	var names []string
+	for _, p := range f.published {
+		if p.Channel != topic {
+			continue
+		}
+		event := &pushv1.Event{}
+		assert.NoError(t, proto.Unmarshal(p.Data, event))
+		names = append(names, event.GetName())
+	}
+	return names
+}
+
+func newTestService(t *testing.T, conf config.Config) (*Service, *fakePubSub) {
+	_, err := logger.NewLogger("dev", nil, nil)
+	assert.NoError(t, err)
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	ps := &fakePubSub{}
+	authorizer, err := authz.New(conf.Authz)
+	assert.NoError(t, err)
+	kvStore := kv.NewRedis(redispkg.NewClient(redispkg.Config{Address: mr.Addr()}))
+	nodes := cluster.NewRegistry(kvStore, conf.Cluster, "")
+	return NewService(ps, ps, kvStore, nil, authorizer, nodes, conf), ps
+}
+
+func newTestSubscription() *subscription.Subscription {
+	return &subscription.Subscription{ID: uuid.New()}
+}
+
+// newTestSession returns a session registered with the service
+func newTestSession(svc *Service, clientID string, device *Device) *Session {
+	session := newSession(clientID, device, newTestSubscription())
+	svc.sessions.Add(session)
+	return session
+}
+
+func TestService_TopicPresence(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{EnableTopicPresence: true, SendTopicPresenceEvents: true})
+
+	device1 := &Device{ID: "device1"}
+	device2 := &Device{ID: "device2"}
+	session1 := newTestSession(svc, "client1", device1)
+	session2 := newTestSession(svc, "client2", device2)
+	session3 := newTestSession(svc, "client2", device2)
+
+	assert.NoError(t, svc.TopicSubscribe(ctx, session1, "cart"))
+	// subscribing again is not a join
+	assert.NoError(t, svc.TopicSubscribe(ctx, session1, "cart"))
+	assert.NoError(t, svc.TopicSubscribe(ctx, session2, "cart"))
+	// another channel of the same device is the same member
+	assert.NoError(t, svc.TopicSubscribe(ctx, session3, "cart"))
+	// patterns are not tracked
+	assert.NoError(t, svc.TopicSubscribe(ctx, session2, "cart.*"))
+
+	members, err := svc.GetTopicPresence(ctx, GetTopicPresenceRequest{topic: "cart"})
+	assert.NoError(t, err)
+	assert.Equal(t, []TopicPresenceMember{{ClientID: "client1", DeviceID: "device1"}, {ClientID: "client2", DeviceID: "device2"}}, members)
+
+	// a member joins on its first channel
+	assert.Equal(t, []string{PresenceJoin, PresenceJoin}, ps.publishedEventNames(t, "cart"))
+
+	assert.NoError(t, svc.TopicUnsubscribe(ctx, session1, "cart"))
+	// another channel of the device is still subscribed
+	assert.NoError(t, svc.ClientUnsubscribe(ctx, session2))
+	assert.Equal(t, []string{PresenceJoin, PresenceJoin, PresenceLeave}, ps.publishedEventNames(t, "cart"))
+
+	// a member leaves on its last channel
+	assert.NoError(t, svc.ClientUnsubscribe(ctx, session3))
+	assert.Equal(t, []string{PresenceJoin, PresenceJoin, PresenceLeave, PresenceLeave}, ps.publishedEventNames(t, "cart"))
+
+	members, err = svc.GetTopicPresence(ctx, GetTopicPresenceRequest{topic: "cart"})
+	assert.NoError(t, err)
+	assert.Empty(t, members)
+}
+
+func TestService_TopicPresence_Stale(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{EnableTopicPresence: true, SendTopicPresenceEvents: true, DeviceTTLInSec: 30})
+
+	// left behind by a crashed node
+	stale, err := json.Marshal(presenceRecord{
+		TopicPresenceMember: TopicPresenceMember{ClientID: "client1", DeviceID: "device1"},
+		LastSeenAt:          time.Now().Add(-time.Minute),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.kv.Store(ctx, presenceKey("cart"), uuid.NewString(), string(stale)))
+
+	members, err := svc.GetTopicPresence(ctx, GetTopicPresenceRequest{topic: "cart"})
+	assert.NoError(t, err)
+	assert.Empty(t, members)
+
+	// the stale channel is not another channel of the member
+	session := newTestSession(svc, "client1", &Device{ID: "device1"})
+	assert.NoError(t, svc.TopicSubscribe(ctx, session, "cart"))
+	assert.Equal(t, []string{PresenceJoin}, ps.publishedEventNames(t, "cart"))
+
+	members, err = svc.GetTopicPresence(ctx, GetTopicPresenceRequest{topic: "cart"})
+	assert.NoError(t, err)
+	assert.Equal(t, []TopicPresenceMember{{ClientID: "client1", DeviceID: "device1"}}, members)
+}
+
+func TestService_GetTopicPresence_Disabled(t *testing.T) {
+	svc, _ := newTestService(t, config.Config{})
+	_, err := svc.GetTopicPresence(context.Background(), GetTopicPresenceRequest{topic: "cart"})
+	assert.Error(t, err)
+}
+
+func TestService_GetClientActiveDevices(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t, config.Config{EnableDeviceSupport: true, DeviceTTLInSec: 30})
+
+	loggedInAt := time.Now().Add(-time.Hour)
+	device := &Device{ID: "device1", Attributes: map[string]string{"x-os": "android"}, LoggedInAt: loggedInAt}
+	assert.NoError(t, svc.storeDevice(ctx, "client1", device))
+
+	// a device which was not refreshed within the ttl
+	stale, err := json.Marshal(deviceRecord{LoggedInAt: loggedInAt, LastSeenAt: time.Now().Add(-time.Minute)})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.kv.Store(ctx, "client1", "device2", string(stale)))
+
+	devices, err := svc.GetClientActiveDevices(ctx, GetClientActiveDevicesRequest{clientID: "client1"})
+	assert.NoError(t, err)
+	if assert.Len(t, devices, 1) {
+		assert.Equal(t, "device1", devices[0].ID)
+		assert.Equal(t, device.Attributes, devices[0].Attributes)
+		assert.True(t, loggedInAt.Equal(devices[0].LoggedInAt))
+		assert.WithinDuration(t, time.Now(), devices[0].LastSeenAt, time.Second)
+	}
+
+	// the stale device is deleted
+	v, err := svc.kv.Load(ctx, "client1")
+	assert.NoError(t, err)
+	assert.NotContains(t, v, "device2")
+}
+
+func TestService_GetClientsOnlineStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("with device support", func(t *testing.T) {
+		svc, _ := newTestService(t, config.Config{EnableDeviceSupport: true, DeviceTTLInSec: 30})
+		assert.NoError(t, svc.storeDevice(ctx, "client1", &Device{ID: "device1"}))
+		assert.NoError(t, svc.storeDevice(ctx, "client1", &Device{ID: "device2"}))
+		stale, err := json.Marshal(deviceRecord{LastSeenAt: time.Now().Add(-time.Minute)})
+		assert.NoError(t, err)
+		assert.NoError(t, svc.kv.Store(ctx, "client2", "device1", string(stale)))
+
+		statuses, err := svc.GetClientsOnlineStatus(ctx, GetClientsOnlineStatusRequest{clientIDs: []string{"client1", "client2", "client3"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []ClientOnlineStatus{
+			{ClientID: "client1", IsOnline: true, DeviceCount: 2},
+			{ClientID: "client2"},
+			{ClientID: "client3"},
+		}, statuses)
+	})
+
+	t.Run("without device support", func(t *testing.T) {
+		svc, _ := newTestService(t, config.Config{})
+		_, _, err := svc.AsyncClientSubscribe(ctx, "client1", nil, ChannelOptions{})
+		assert.NoError(t, err)
+		// left behind by a crashed node
+		stale, err := json.Marshal(connectionRecord{LastSeenAt: time.Now().Add(-time.Hour)})
+		assert.NoError(t, err)
+		assert.NoError(t, svc.kv.Store(ctx, connectionsKey("client2"), uuid.NewString(), string(stale)))
+
+		statuses, err := svc.GetClientsOnlineStatus(ctx, GetClientsOnlineStatusRequest{clientIDs: []string{"client1", "client2", "client3"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []ClientOnlineStatus{{ClientID: "client1", IsOnline: true}, {ClientID: "client2"}, {ClientID: "client3"}}, statuses)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		svc, _ := newTestService(t, config.Config{})
+		_, err := svc.GetClientsOnlineStatus(ctx, GetClientsOnlineStatusRequest{})
+		assert.Error(t, err)
+		_, err = svc.GetClientsOnlineStatus(ctx, GetClientsOnlineStatusRequest{clientIDs: make([]string, maxOnlineStatusClients+1)})
+		assert.Error(t, err)
+	})
+}
+
+func TestService_DisconnectClient(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{EnableDeviceSupport: true})
+
+	// no live channels to disconnect
+	assert.NoError(t, svc.DisconnectClient(ctx, DisconnectClientRequest{clientID: "client1", reason: "logout"}))
+	assert.Empty(t, ps.published)
+
+	session1, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+	assert.NoError(t, err)
+	session2, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device2"}, ChannelOptions{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, svc.DisconnectClient(ctx, DisconnectClientRequest{clientID: "client1", reason: "logout"}))
+	assert.NoError(t, svc.DisconnectClient(ctx, DisconnectClientRequest{clientID: "client1", deviceID: "device1", reason: "suspended"}))
+	assert.Error(t, svc.DisconnectClient(ctx, DisconnectClientRequest{}))
+
+	controlEvents := publishedControlEvents(t, svc, ps, "client1")
+	if !assert.Len(t, controlEvents, 2) {
+		return
+	}
+	channelIDs := []string{session1.ID, session2.ID}
+	sort.Strings(channelIDs)
+	assert.Equal(t, channelIDs, controlEvents[0].GetDisconnect().GetChannelIds())
+	assert.Equal(t, "suspended", controlEvents[1].GetDisconnect().GetReason())
+	assert.Equal(t, []string{session1.ID}, controlEvents[1].GetDisconnect().GetChannelIds())
+	assert.WithinDuration(t, time.Now(), controlEvents[1].GetIssuedAt().AsTime(), time.Second)
+
+	// only the channels connected when the disconnect was sent act on it
+	assert.True(t, svc.IsControlEventTarget(controlEvents[1], session1))
+	assert.False(t, svc.IsControlEventTarget(controlEvents[1], session2))
+	session3, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.False(t, svc.IsControlEventTarget(controlEvents[1], session3))
+
+	controlEvent, err := svc.GetControlEvent(ctx, &pushv1.Event{Name: "order-updated"})
+	assert.NoError(t, err)
+	assert.Nil(t, controlEvent)
+}
+
+// publishedControlEvents returns the control events published to the channels of the client
+func publishedControlEvents(t *testing.T, svc *Service, ps *fakePubSub, clientID string) []*pushv1.ControlEvent {
+	var controlEvents []*pushv1.ControlEvent
+	for _, p := range ps.published {
+		if p.Channel != controlSubject(clientID) {
+			continue
+		}
+		event := &pushv1.Event{}
+		assert.NoError(t, proto.Unmarshal(p.Data, event))
+		controlEvent, err := svc.GetControlEvent(context.Background(), event)
+		assert.NoError(t, err)
+		controlEvents = append(controlEvents, controlEvent)
+	}
+	return controlEvents
+}
+
+func TestService_SubscribeClientToTopic(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{EnableDeviceSupport: true})
+
+	// no live channel receives the control event
+	err := svc.SubscribeClientToTopic(ctx, SubscribeClientToTopicRequest{clientID: "client1", topic: "group.1"})
+	if pErr, ok := err.(*perror.PError); assert.True(t, ok) {
+		assert.Equal(t, perror.FailedPrecondition, pErr.Code())
+	}
+	assert.Empty(t, ps.published)
+
+	_, _, err = svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.SubscribeClientToTopic(ctx, SubscribeClientToTopicRequest{clientID: "client1", topic: "group.1"}))
+	assert.NoError(t, svc.UnsubscribeClientFromTopic(ctx, UnsubscribeClientFromTopicRequest{clientID: "client1", deviceID: "device1", topic: "group.1"}))
+	assert.Error(t, svc.SubscribeClientToTopic(ctx, SubscribeClientToTopicRequest{clientID: "client1"}))
+	assert.Error(t, svc.SubscribeClientToTopic(ctx, SubscribeClientToTopicRequest{clientID: "client1", topic: "group.>.1"}))
+	// another device has no live channel
+	assert.Error(t, svc.SubscribeClientToTopic(ctx, SubscribeClientToTopicRequest{clientID: "client1", deviceID: "device2", topic: "group.1"}))
+
+	controlEvents := publishedControlEvents(t, svc, ps, "client1")
+	if !assert.Len(t, controlEvents, 2) {
+		return
+	}
+	assert.Equal(t, "group.1", controlEvents[0].GetSubscribe().GetTopic())
+	assert.Empty(t, controlEvents[0].GetDeviceId())
+	assert.Equal(t, "group.1", controlEvents[1].GetUnsubscribe().GetTopic())
+	assert.Equal(t, "device1", controlEvents[1].GetDeviceId())
+
+	// the channels of other devices do not act on it
+	assert.True(t, svc.IsControlEventTarget(controlEvents[1], newSession("client1", &Device{ID: "device1"}, newTestSubscription())))
+	assert.False(t, svc.IsControlEventTarget(controlEvents[1], newSession("client1", &Device{ID: "device2"}, newTestSubscription())))
+}
+
+func TestService_SubscribeClientToTopic_Offline(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{EnableDeviceSupport: true, PersistTopicSubscriptions: true})
+
+	// the topic is persisted for the device to subscribe to on connect
+	assert.NoError(t, svc.SubscribeClientToTopic(ctx, SubscribeClientToTopicRequest{clientID: "client1", deviceID: "device1", topic: "group.1"}))
+	assert.NoError(t, svc.SubscribeClientToTopic(ctx, SubscribeClientToTopicRequest{clientID: "client1", deviceID: "device1", topic: "group.2"}))
+	assert.NoError(t, svc.UnsubscribeClientFromTopic(ctx, UnsubscribeClientFromTopicRequest{clientID: "client1", deviceID: "device1", topic: "group.2"}))
+	// the devices to persist for are not known
+	assert.Error(t, svc.SubscribeClientToTopic(ctx, SubscribeClientToTopicRequest{clientID: "client1", topic: "group.1"}))
+	assert.Empty(t, ps.published)
+
+	_, result, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"group.1"}, result.RestoredTopics)
+}
+
+func TestService_awaitReplies(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{})
+	s := &subscription.Subscription{ID: uuid.New(), TopicEventChan: make(chan broker.TopicEvent, 2)}
+	replies := svc.awaitReplies(ctx, s, Recipient{ClientID: "client1", DeviceID: "device1"}, 1, time.Second)
+
+	// another client, or another device of the client, can not reply
+	other := newTestSession(svc, "client2", &Device{ID: "device1"})
+	assert.NoError(t, svc.PublishReply(ctx, other, &pushv1.Event{Name: "other", CorrelationId: "id1"}))
+	target := newTestSession(svc, "client1", &Device{ID: "device1"})
+	assert.NoError(t, svc.PublishReply(ctx, target, &pushv1.Event{Name: "reply", CorrelationId: "id1"}))
+	for _, published := range ps.published {
+		assert.Equal(t, replyTopic("id1"), published.Channel)
+		s.TopicEventChan <- broker.TopicEvent{Event: published.Data}
+	}
+
+	r := <-replies
+	if assert.Len(t, r, 1) {
+		assert.Equal(t, "reply", r[0].GetName())
+	}
+}
+
+type fakeInbox struct {
+	mu     sync.Mutex
+	events map[string][][]byte
+}
+
+func (f *fakeInbox) Push(ctx context.Context, clientID string, event []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events[clientID] = append(f.events[clientID], event)
+	return nil
+}
+
+func (f *fakeInbox) Drain(ctx context.Context, clientID string) ([][]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	events := f.events[clientID]
+	delete(f.events, clientID)
+	return events, nil
+}
+
+func TestService_keepInboxDrained(t *testing.T) {
+	svc, _ := newTestService(t, config.Config{DeviceTTLInSec: 3})
+	inbox := &fakeInbox{events: map[string][][]byte{"client1": {[]byte("e1")}}}
+	svc.inbox = inbox
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &subscription.Subscription{ID: uuid.New(), TopicEventChan: make(chan broker.TopicEvent, 2)}
+	go svc.keepInboxDrained(ctx, "client1", s)
+
+	assert.Equal(t, "e1", string((<-s.TopicEventChan).Event))
+
+	// stored by a publisher which checked the client offline before the connection was stored
+	assert.NoError(t, inbox.Push(ctx, "client1", []byte("e2")))
+	select {
+	case te := <-s.TopicEventChan:
+		assert.Equal(t, "e2", string(te.Event))
+	case <-time.After(3 * time.Second):
+		t.Fatal("inbox not drained again")
+	}
+}
+
+func TestService_IsClientOnline(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t, config.Config{})
+	assert.False(t, svc.isClientOnline(ctx, "client1"))
+
+	// left behind by a crashed node
+	stale, err := json.Marshal(connectionRecord{LastSeenAt: time.Now().Add(-time.Hour)})
+	assert.NoError(t, err)
+	assert.NoError(t, svc.kv.Store(ctx, connectionsKey("client1"), "channel1", string(stale)))
+	assert.False(t, svc.isClientOnline(ctx, "client1"))
+
+	_, _, err = svc.AsyncClientSubscribe(ctx, "client1", nil, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.True(t, svc.isClientOnline(ctx, "client1"))
+}
+
+func TestService_BroadcastTopic(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{BroadcastTopic: "broadcast"})
+
+	// a resumed channel is subscribed to broadcast too
+	session, _, err := svc.AsyncClientSubscribe(ctx, "client1", nil, ChannelOptions{Cursor: "42"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, ps.subscriptionCount(session.Subscription, "broadcast"))
+
+	// the broadcast topic is reserved
+	assert.Error(t, svc.TopicSubscribe(ctx, session, "broadcast"))
+	assert.Error(t, svc.TopicSubscribe(ctx, session, ">"))
+	assert.Error(t, svc.ServerTopicSubscribe(ctx, session, "broadcast"))
+	assert.NoError(t, svc.TopicSubscribe(ctx, session, "orders"))
+	_, err = svc.PublishToTopic(ctx, SendEventToTopicRequest{Topic: "broadcast", Event: []byte("e"), EventName: "e"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, ps.subscriptionCount(session.Subscription, "broadcast"))
+}
+
+func TestService_PersistTopicSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t, config.Config{
+		PersistTopicSubscriptions: true,
+		Authz:                     authz.Config{Enabled: true, DenyPatterns: []string{"admin.*"}},
+	})
+	device := &Device{}
+
+	session := newTestSession(svc, "client1", device)
+	assert.NoError(t, svc.TopicSubscribe(ctx, session, "orders"))
+	assert.NoError(t, svc.TopicSubscribe(ctx, session, "prices"))
+	// server subscriptions are not authorized
+	assert.NoError(t, svc.ServerTopicSubscribe(ctx, session, "admin.alerts"))
+	assert.NoError(t, svc.TopicUnsubscribe(ctx, session, "prices"))
+
+	_, result, err := svc.AsyncClientSubscribe(ctx, "client1", device, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"admin.alerts", "orders"}, result.RestoredTopics)
+
+	_, result, err = svc.AsyncClientSubscribe(ctx, "client1", device, ChannelOptions{TopicRestore: TopicRestoreSkip})
+	assert.NoError(t, err)
+	assert.Empty(t, result.RestoredTopics)
+
+	_, result, err = svc.AsyncClientSubscribe(ctx, "client1", device, ChannelOptions{TopicRestore: TopicRestoreReset})
+	assert.NoError(t, err)
+	assert.Empty(t, result.RestoredTopics)
+
+	_, result, err = svc.AsyncClientSubscribe(ctx, "client1", device, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, result.RestoredTopics)
+}
+
+func TestService_ConnectTopics(t *testing.T) {
+	ctx := context.Background()
+	svc, pubSub := newTestService(t, config.Config{
+		PersistTopicSubscriptions: true,
+		Authz:                     authz.Config{Enabled: true, DenyPatterns: []string{"admin.*"}},
+	})
+	device := &Device{}
+
+	assert.NoError(t, svc.TopicSubscribe(ctx, newTestSession(svc, "client1", device), "orders"))
+
+	session, result, err := svc.AsyncClientSubscribe(ctx, "client1", device, ChannelOptions{
+		Topics: []string{"orders", "prices", "admin.alerts", "prices"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"orders"}, result.RestoredTopics)
+	assert.Len(t, result.Topics, 4)
+	assert.NoError(t, result.Topics[0].Err)
+	assert.NoError(t, result.Topics[1].Err)
+	assert.Error(t, result.Topics[2].Err)
+	assert.NoError(t, result.Topics[3].Err)
+	// restored and repeated topics are subscribed to only once
+	assert.Equal(t, 1, pubSub.subscriptionCount(session.Subscription, "orders"))
+	assert.Equal(t, 1, pubSub.subscriptionCount(session.Subscription, "prices"))
+	assert.Equal(t, 0, pubSub.subscriptionCount(session.Subscription, "admin.alerts"))
+
+	topics := make([]string, maxConnectTopics+1)
+	_, _, err = svc.AsyncClientSubscribe(ctx, "client1", device, ChannelOptions{Topics: topics})
+	assert.Error(t, err)
+}
+
+func TestService_Sessions(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(t, config.Config{})
+
+	session1, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+	assert.NoError(t, err)
+	session2, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device2"}, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, svc.Sessions().Len())
+	assert.Len(t, svc.Sessions().ClientSessions("client1"), 2)
+	assert.Empty(t, svc.Sessions().ClientSessions("client2"))
+	assert.WithinDuration(t, time.Now(), session1.StartedAt, time.Second)
+
+	assert.NoError(t, svc.TopicSubscribe(ctx, session1, "orders"))
+	assert.NoError(t, svc.TopicSubscribe(ctx, session1, "orders"))
+	assert.Equal(t, []string{"orders"}, session1.Topics())
+	assert.Empty(t, session2.Topics())
+
+	// the tasks of a session are stopped when it is closed
+	stopped := make(chan struct{})
+	session1.startTask(ctx, "task", func(ctx context.Context) {
+		<-ctx.Done()
+		close(stopped)
+	})
+	session1.RecordEventSent()
+	assert.Equal(t, SessionStats{EventsSent: 1, Topics: 1}, session1.Stats())
+
+	assert.NoError(t, svc.ClientUnsubscribe(ctx, session1))
+	// closing again is ignored
+	assert.NoError(t, svc.ClientUnsubscribe(ctx, session1))
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("task of the closed session is running")
+	}
+	_, ok := svc.Sessions().Get(session1.ID)
+	assert.False(t, ok)
+	got, ok := svc.Sessions().Get(session2.ID)
+	assert.True(t, ok)
+	assert.Same(t, session2, got)
+	assert.Empty(t, session1.Topics())
+}
+
+// evictedChannelIDs returns the ids of the channels of the client evicted by disconnect control events
+func evictedChannelIDs(t *testing.T, svc *Service, ps *fakePubSub, clientID string) []string {
+	var ids []string
+	for _, controlEvent := range publishedControlEvents(t, svc, ps, clientID) {
+		ids = append(ids, controlEvent.GetDisconnect().GetChannelIds()...)
+	}
+	return ids
+}
+
+func TestService_AsyncClientSubscribe_DeviceFailure(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{EnableDeviceSupport: true})
+	ps.addErr = assert.AnError
+
+	_, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+	assert.Error(t, err)
+
+	// the client and control subscriptions and the connection record are released
+	assert.Len(t, ps.unsubscribed, 2)
+	connections, err := svc.kv.Load(ctx, connectionsKey("client1"))
+	assert.NoError(t, err)
+	assert.Empty(t, connections)
+	assert.Empty(t, svc.sessions.ClientSessions("client1"))
+}
+
+func TestService_ChannelLimits(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reject", func(t *testing.T) {
+		svc, _ := newTestService(t, config.Config{MaxChannelsPerClient: 2, MaxChannelsPerDevice: 1, ChannelLimitPolicy: ChannelLimitReject})
+		// channels of a crashed node are not counted
+		stale, err := json.Marshal(connectionRecord{DeviceID: "device1", LastSeenAt: time.Now().Add(-time.Minute)})
+		assert.NoError(t, err)
+		assert.NoError(t, svc.kv.Store(ctx, connectionsKey("client1"), uuid.NewString(), string(stale)))
+
+		_, _, err = svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+		assert.NoError(t, err)
+		_, _, err = svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+		assert.Error(t, err)
+		_, _, err = svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device2"}, ChannelOptions{})
+		assert.NoError(t, err)
+		_, _, err = svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device3"}, ChannelOptions{})
+		assert.Error(t, err)
+		// other clients are not limited by the channels of the client
+		_, _, err = svc.AsyncClientSubscribe(ctx, "client2", &Device{ID: "device1"}, ChannelOptions{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("evict oldest", func(t *testing.T) {
+		svc, ps := newTestService(t, config.Config{MaxChannelsPerClient: 2, MaxChannelsPerDevice: 1, ChannelLimitPolicy: ChannelLimitEvictOldest})
+		session1, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+		assert.NoError(t, err)
+		session2, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device2"}, ChannelOptions{})
+		assert.NoError(t, err)
+		// the older channel of the device is evicted
+		session3, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{session1.ID}, evictedChannelIDs(t, svc, ps, "client1"))
+		// the oldest channel of the client is evicted
+		_, _, err = svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device3"}, ChannelOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{session1.ID, session2.ID}, evictedChannelIDs(t, svc, ps, "client1"))
+
+		v, err := svc.kv.Load(ctx, connectionsKey("client1"))
+		assert.NoError(t, err)
+		assert.Len(t, v, 2)
+		assert.Contains(t, v, session3.ID)
+	})
+}
+
+func TestService_SingleActiveDevice(t *testing.T) {
+	ctx := context.Background()
+	svc, ps := newTestService(t, config.Config{EnableDeviceSupport: true, SingleActiveDevice: true})
+
+	// no other device to replace
+	session1, _, err := svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device1"}, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, publishedControlEvents(t, svc, ps, "client1"))
+
+	_, _, err = svc.AsyncClientSubscribe(ctx, "client1", &Device{ID: "device2", Attributes: map[string]string{"x-os": "ios"}}, ChannelOptions{})
+	assert.NoError(t, err)
+
+	controlEvents := publishedControlEvents(t, svc, ps, "client1")
+	if !assert.Len(t, controlEvents, 1) {
+		return
+	}
+	disconnect := controlEvents[0].GetDisconnect()
+	assert.Equal(t, []string{session1.ID}, disconnect.GetChannelIds())
+	assert.Equal(t, SessionReplacedEventName, disconnect.GetEvent().GetName())
+	device := &pushv1.Device{}
+	assert.NoError(t, disconnect.GetEvent().GetData().UnmarshalTo(device))
+	assert.Equal(t, "device2", device.GetId())
+	assert.Equal(t, "ios", device.GetAttributes()["x-os"])
+
+	// without device support there are no devices to replace
+	svc, ps = newTestService(t, config.Config{SingleActiveDevice: true})
+	_, _, err = svc.AsyncClientSubscribe(ctx, "client1", &Device{}, ChannelOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, ps.published)
+}
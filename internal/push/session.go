@@ -0,0 +1,214 @@
+package push
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/ack"
+	"github.com/CRED-CLUB/propeller/internal/pubsub/subscription"
+)
+
+// Session is a channel of a client connected to this node, from connect till the channel is closed.
+// Everything done on behalf of the channel is done on its session.
+type Session struct {
+	// ID of the session, same as the ID of its subscription
+	ID           string
+	ClientID     string
+	Device       *Device
+	Subscription *subscription.Subscription
+	// ControlSubscription receives the control events of the client
+	ControlSubscription *subscription.Subscription
+	StartedAt           time.Time
+	// Filters of the events delivered on the channel
+	Filters *ChannelFilters
+	// AckTracker tracks the events sent on the channel till acked, nil if acks are disabled
+	AckTracker *ack.Tracker
+
+	mu sync.Mutex
+	// topics the channel is subscribed to other than the client topics
+	topics map[string]struct{}
+	// cancelFuncs stop the background tasks of the session by name
+	cancelFuncs map[string]context.CancelFunc
+	closed      bool
+
+	eventsSent     atomic.Int64
+	eventsReceived atomic.Int64
+}
+
+// SessionStats are the counters of a session
+type SessionStats struct {
+	EventsSent     int64
+	EventsReceived int64
+	Topics         int
+}
+
+func newSession(clientID string, device *Device, clientSubscription *subscription.Subscription) *Session {
+	return &Session{
+		ID:           clientSubscription.ID.String(),
+		ClientID:     clientID,
+		Device:       device,
+		Subscription: clientSubscription,
+		StartedAt:    time.Now(),
+		Filters:      NewChannelFilters(),
+		topics:       make(map[string]struct{}),
+		cancelFuncs:  make(map[string]context.CancelFunc),
+	}
+}
+
+// DeviceID returns the id of the device of the session, empty without device
+func (s *Session) DeviceID() string {
+	if s.Device == nil {
+		return ""
+	}
+	return s.Device.ID
+}
+
+// Topics returns the sorted topics the session is subscribed to other than the client topics
+func (s *Session) Topics() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	topics := make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// addTopic returns false if the session is already subscribed to the topic
+func (s *Session) addTopic(topic string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.topics[topic]; ok {
+		return false
+	}
+	s.topics[topic] = struct{}{}
+	return true
+}
+
+// removeTopic returns false if the session is not subscribed to the topic
+func (s *Session) removeTopic(topic string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.topics[topic]; !ok {
+		return false
+	}
+	delete(s.topics, topic)
+	return true
+}
+
+// startTask runs fn in the background till the task is stopped or the session is closed,
+// a task already running with the name is not started again
+func (s *Session) startTask(ctx context.Context, name string, fn func(ctx context.Context)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.cancelFuncs[name]; ok || s.closed {
+		return
+	}
+	taskCtx, cancel := context.WithCancel(ctx)
+	s.cancelFuncs[name] = cancel
+	go fn(taskCtx)
+}
+
+// stopTask stops the background task with the name, if running
+func (s *Session) stopTask(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cancel, ok := s.cancelFuncs[name]; ok {
+		cancel()
+		delete(s.cancelFuncs, name)
+	}
+}
+
+// close stops all background tasks of the session
+func (s *Session) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, cancel := range s.cancelFuncs {
+		cancel()
+	}
+	s.cancelFuncs = make(map[string]context.CancelFunc)
+	s.closed = true
+}
+
+// RecordEventSent counts an event sent on the channel
+func (s *Session) RecordEventSent() {
+	s.eventsSent.Add(1)
+}
+
+// RecordEventReceived counts an event received from the client
+func (s *Session) RecordEventReceived() {
+	s.eventsReceived.Add(1)
+}
+
+// Stats returns the counters of the session
+func (s *Session) Stats() SessionStats {
+	s.mu.Lock()
+	topics := len(s.topics)
+	s.mu.Unlock()
+	return SessionStats{
+		EventsSent:     s.eventsSent.Load(),
+		EventsReceived: s.eventsReceived.Load(),
+		Topics:         topics,
+	}
+}
+
+// SessionRegistry holds the sessions connected to this node
+type SessionRegistry struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+}
+
+// NewSessionRegistry returns an empty SessionRegistry
+func NewSessionRegistry() *SessionRegistry {
+	return &SessionRegistry{sessions: make(map[string]*Session)}
+}
+
+// Add registers the session
+func (r *SessionRegistry) Add(s *Session) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[s.ID] = s
+}
+
+// Remove deregisters the session, returns false if it was not registered
+func (r *SessionRegistry) Remove(s *Session) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.sessions[s.ID]; !ok {
+		return false
+	}
+	delete(r.sessions, s.ID)
+	return true
+}
+
+// Get returns the session with the id
+func (r *SessionRegistry) Get(id string) (*Session, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.sessions[id]
+	return s, ok
+}
+
+// ClientSessions returns the sessions of the client on this node
+func (r *SessionRegistry) ClientSessions(clientID string) []*Session {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var sessions []*Session
+	for _, s := range r.sessions {
+		if s.ClientID == clientID {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions
+}
+
+// Len returns the number of sessions on this node
+func (r *SessionRegistry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.sessions)
+}
@@ -0,0 +1,20 @@
+package sendqueue
+
+// Config for the outbound queue of a channel
+type Config struct {
+	// Enabled lets Policy drop events of a full queue, otherwise a channel with a full queue is disconnected
+	Enabled bool
+	// Size is the number of events queued for a channel before Policy applies
+	Size   int
+	Policy Policy
+}
+
+// HandOff returns the config of the queue events are handed to a subscription through. Events are dropped
+// only if the queue is enabled and events are not persisted, otherwise a subscription with a full queue is
+// disconnected as a slow consumer, so that persisted events are resumed from the cursor instead of being lost.
+func (c Config) HandOff(persistence bool) Config {
+	if c.Enabled && !persistence {
+		return c
+	}
+	return Config{Enabled: c.Enabled, Size: c.Size, Policy: PolicyDisconnect}
+}
@@ -0,0 +1,32 @@
+package sendqueue
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	queuedEvents = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "propeller_send_queue_events",
+		Help: "The number of events queued for sending across all channels",
+	})
+
+	queueDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "propeller_send_queue_depth",
+		Help:    "Depth of the send queue of a channel when an event is queued",
+		Buckets: []float64{0, 1, 2, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000},
+	})
+
+	eventsDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "propeller_send_queue_dropped_total",
+			Help: "Total number of events dropped as the send queue of a channel was full",
+		},
+		[]string{"policy"},
+	)
+
+	slowConsumers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "propeller_send_queue_slow_consumers_disconnected_total",
+		Help: "Total number of channels disconnected as their send queue was full",
+	})
+)
@@ -0,0 +1,116 @@
+package sendqueue
+
+import (
+	"context"
+
+	"github.com/CRED-CLUB/propeller/pkg/broker"
+)
+
+// Policy decides what happens to an event received when the queue is full
+type Policy string
+
+const (
+	// PolicyDropOldest drops the oldest queued event to queue the received one
+	PolicyDropOldest Policy = "drop_oldest"
+	// PolicyDropNewest drops the received event
+	PolicyDropNewest Policy = "drop_newest"
+	// PolicyDisconnect closes the queue, the channel is to be disconnected as a slow consumer
+	PolicyDisconnect Policy = "disconnect"
+
+	defaultSize = 1000
+)
+
+// Queue is a bounded outbound queue of a channel. Events are taken from the subscription as soon as they
+// are received, so that a slow client does not block the broker, and are handed out as the channel sends them.
+type Queue struct {
+	size     int
+	policy   Policy
+	items    []broker.TopicEvent
+	out      chan broker.TopicEvent
+	overflow chan struct{}
+}
+
+// NewQueue returns a new queue
+func NewQueue(config Config) *Queue {
+	size := config.Size
+	if size <= 0 {
+		size = defaultSize
+	}
+	policy := config.Policy
+	switch policy {
+	case PolicyDropOldest, PolicyDropNewest, PolicyDisconnect:
+	default:
+		policy = PolicyDropOldest
+	}
+	return &Queue{
+		size:     size,
+		policy:   policy,
+		out:      make(chan broker.TopicEvent),
+		overflow: make(chan struct{}),
+	}
+}
+
+// Start queueing events received on in till ctx is done
+func (q *Queue) Start(ctx context.Context, in <-chan broker.TopicEvent) {
+	go q.run(ctx, in)
+}
+
+// Out returns the channel of events to send
+func (q *Queue) Out() <-chan broker.TopicEvent {
+	return q.out
+}
+
+// Overflow is closed when the queue is full with PolicyDisconnect, no events are queued afterwards
+func (q *Queue) Overflow() <-chan struct{} {
+	return q.overflow
+}
+
+func (q *Queue) run(ctx context.Context, in <-chan broker.TopicEvent) {
+	defer func() {
+		queuedEvents.Sub(float64(len(q.items)))
+	}()
+	for {
+		// out is only selected when there is an event to send
+		var out chan broker.TopicEvent
+		var next broker.TopicEvent
+		if len(q.items) > 0 {
+			out = q.out
+			next = q.items[0]
+		}
+		select {
+		case event := <-in:
+			if !q.push(event) {
+				slowConsumers.Inc()
+				close(q.overflow)
+				return
+			}
+		case out <- next:
+			q.items[0] = broker.TopicEvent{}
+			q.items = q.items[1:]
+			queuedEvents.Dec()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// push queues the event, returns false if the queue is full with PolicyDisconnect
+func (q *Queue) push(event broker.TopicEvent) bool {
+	queueDepth.Observe(float64(len(q.items)))
+	if len(q.items) < q.size {
+		q.items = append(q.items, event)
+		queuedEvents.Inc()
+		return true
+	}
+	switch q.policy {
+	case PolicyDropNewest:
+		eventsDropped.WithLabelValues(string(q.policy)).Inc()
+	case PolicyDisconnect:
+		return false
+	default:
+		eventsDropped.WithLabelValues(string(q.policy)).Inc()
+		q.items[0] = broker.TopicEvent{}
+		q.items = append(q.items[1:], event)
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+package sendqueue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/pkg/broker"
+	"github.com/stretchr/testify/assert"
+)
+
+// fill sends the events to the queue without taking any out
+func fill(in chan broker.TopicEvent, topics ...string) {
+	for _, topic := range topics {
+		in <- broker.TopicEvent{Topic: topic}
+	}
+}
+
+// drain takes all queued events out of the queue
+func drain(t *testing.T, q *Queue) []string {
+	var topics []string
+	for {
+		select {
+		case event := <-q.Out():
+			topics = append(topics, event.Topic)
+		case <-time.After(50 * time.Millisecond):
+			return topics
+		}
+	}
+}
+
+func TestQueue_Policies(t *testing.T) {
+	tests := []struct {
+		policy Policy
+		want   []string
+	}{
+		{policy: PolicyDropOldest, want: []string{"b", "c"}},
+		{policy: PolicyDropNewest, want: []string{"a", "b"}},
+		{policy: "", want: []string{"b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			in := make(chan broker.TopicEvent)
+			q := NewQueue(Config{Enabled: true, Size: 2, Policy: tt.policy})
+			q.Start(ctx, in)
+
+			fill(in, "a", "b", "c")
+			assert.Equal(t, tt.want, drain(t, q))
+
+			// the queue accepts events again once drained
+			fill(in, "d")
+			assert.Equal(t, []string{"d"}, drain(t, q))
+		})
+	}
+}
+
+func TestQueue_Disconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	in := make(chan broker.TopicEvent)
+	q := NewQueue(Config{Enabled: true, Size: 2, Policy: PolicyDisconnect})
+	q.Start(ctx, in)
+
+	fill(in, "a", "b")
+	select {
+	case <-q.Overflow():
+		t.Fatal("queue overflowed before it was full")
+	default:
+	}
+
+	fill(in, "c")
+	select {
+	case <-q.Overflow():
+	case <-time.After(time.Second):
+		t.Fatal("queue did not overflow")
+	}
+}
+
+func TestConfig_HandOff(t *testing.T) {
+	config := Config{Enabled: true, Size: 2, Policy: PolicyDropNewest}
+	assert.Equal(t, config, config.HandOff(false))
+	// persisted events are never dropped
+	assert.Equal(t, Config{Enabled: true, Size: 2, Policy: PolicyDisconnect}, config.HandOff(true))
+	// events are dropped only if enabled
+	assert.Equal(t, Config{Size: 2, Policy: PolicyDisconnect}, Config{Size: 2, Policy: PolicyDropOldest}.HandOff(false))
+}
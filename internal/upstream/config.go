@@ -0,0 +1,11 @@
+package upstream
+
+// Config for routing events sent by clients to the backend
+type Config struct {
+	Enabled         bool
+	SubjectPrefix   string
+	HTTPEndpoint    string
+	HTTPTimeoutInMs int
+	// EventNames are the event names clients may send, any valid event name if empty
+	EventNames []string
+}
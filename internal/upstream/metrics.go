@@ -0,0 +1,23 @@
+package upstream
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	eventsRouted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "propeller_upstream_events_routed_total",
+		Help: "Total number of client events routed upstream",
+	}, []string{"event_name"})
+
+	eventsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "propeller_upstream_events_failed_total",
+		Help: "Total number of client events which failed to be routed upstream",
+	}, []string{"event_name"})
+
+	forwardsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "propeller_upstream_forwards_failed_total",
+		Help: "Total number of client events routed upstream which failed to be forwarded to the HTTP endpoint",
+	}, []string{"event_name"})
+)
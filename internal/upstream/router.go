@@ -0,0 +1,184 @@
+package upstream
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/internal/pubsub"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultSubjectPrefix = "upstream"
+	defaultHTTPTimeout   = 5 * time.Second
+	// maxPendingForwards bounds the events being forwarded to the HTTP endpoint at a time
+	maxPendingForwards = 1000
+	// otherEventName is the metrics label of event names which are not configured
+	otherEventName = "other"
+)
+
+// eventNamePattern allows event names which are a single token of a broker subject
+var eventNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// Router routes events sent by clients to the backend
+type Router struct {
+	pubSub        pubsub.IPubSub
+	httpClient    *http.Client
+	subjectPrefix string
+	httpEndpoint  string
+	eventNames    map[string]struct{}
+	// pendingForwards holds a slot for every event being forwarded to the HTTP endpoint
+	pendingForwards chan struct{}
+}
+
+// NewRouter returns a new Router, events are published on pubSub without persistence as the subjects
+// are not valid stream names
+func NewRouter(pubSub pubsub.IPubSub, config Config) *Router {
+	r := &Router{
+		pubSub:        pubSub,
+		httpClient:    &http.Client{Timeout: defaultHTTPTimeout},
+		subjectPrefix: defaultSubjectPrefix,
+		httpEndpoint:  config.HTTPEndpoint,
+		eventNames:    make(map[string]struct{}),
+
+		pendingForwards: make(chan struct{}, maxPendingForwards),
+	}
+	for _, name := range config.EventNames {
+		r.eventNames[name] = struct{}{}
+	}
+	if config.SubjectPrefix != "" {
+		r.subjectPrefix = config.SubjectPrefix
+	}
+	if config.HTTPTimeoutInMs > 0 {
+		r.httpClient.Timeout = time.Duration(config.HTTPTimeoutInMs) * time.Millisecond
+	}
+	return r
+}
+
+// Subject returns the broker subject events with the name are published to
+func (r *Router) Subject(eventName string) string {
+	return fmt.Sprintf("%s.%s", r.subjectPrefix, eventName)
+}
+
+// Route publishes the event to the broker, and forwards it to the HTTP endpoint if configured in
+// the background, so that a slow endpoint does not hold up the channel of the client. An error is
+// returned if the event is not published or too many events are pending forward
+func (r *Router) Route(ctx context.Context, event *pushv1.UpstreamEvent) error {
+	eventName := event.GetChannelEvent().GetEvent().GetName()
+	label := r.metricsLabel(eventName)
+	err := r.validateEventName(ctx, eventName)
+	if err == nil {
+		err = r.route(ctx, event, label)
+	}
+	if err != nil {
+		eventsFailed.WithLabelValues(label).Inc()
+		return err
+	}
+	eventsRouted.WithLabelValues(label).Inc()
+	return nil
+}
+
+// validateEventName checks that the event name is a single token of a broker subject, so that a client
+// can not publish to other subjects, and that it is one of the configured event names if any
+func (r *Router) validateEventName(ctx context.Context, eventName string) error {
+	if !eventNamePattern.MatchString(eventName) {
+		pErr := perror.Newf(perror.InvalidArgument, "invalid event name %q", eventName)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	if _, ok := r.eventNames[eventName]; len(r.eventNames) > 0 && !ok {
+		pErr := perror.Newf(perror.PermissionDenied, "event name %s is not allowed", eventName)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return nil
+}
+
+// metricsLabel returns the event name if configured, so that clients do not add labels
+func (r *Router) metricsLabel(eventName string) string {
+	if _, ok := r.eventNames[eventName]; ok {
+		return eventName
+	}
+	return otherEventName
+}
+
+func (r *Router) route(ctx context.Context, event *pushv1.UpstreamEvent, label string) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to marshal upstream event %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	// the slot is taken before publishing, so that an event which can not be forwarded is rejected as a whole
+	if r.httpEndpoint != "" {
+		select {
+		case r.pendingForwards <- struct{}{}:
+		default:
+			pErr := perror.New(perror.ResourceExhausted, "too many upstream events pending forward")
+			logger.Ctx(ctx).Error(pErr.Error())
+			forwardsFailed.WithLabelValues(label).Inc()
+			return pErr
+		}
+	}
+	subject := r.Subject(event.GetChannelEvent().GetEvent().GetName())
+	err = r.pubSub.Publish(ctx, pubsub.PublishRequest{Channel: subject, Data: data})
+	if err != nil {
+		if r.httpEndpoint != "" {
+			<-r.pendingForwards
+		}
+		return err
+	}
+	if r.httpEndpoint == "" {
+		return nil
+	}
+	// the channel may be closed before the event is forwarded, the request is bound by the client timeout
+	go func() {
+		defer func() { <-r.pendingForwards }()
+		err := r.forward(context.WithoutCancel(ctx), event)
+		if err != nil {
+			forwardsFailed.WithLabelValues(label).Inc()
+		}
+	}()
+	return nil
+}
+
+// forward posts the event as JSON to the HTTP endpoint
+func (r *Router) forward(ctx context.Context, event *pushv1.UpstreamEvent) error {
+	body, err := protojson.Marshal(event)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to marshal upstream event to json %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.httpEndpoint, bytes.NewReader(body))
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to create upstream request %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		pErr := perror.Newf(perror.Unavailable, "error in forwarding upstream event %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	defer resp.Body.Close()
+	// drain so that the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		pErr := perror.Newf(perror.Unavailable, "upstream endpoint returned status %d", resp.StatusCode)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package upstream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/pubsub"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	pushv1 "github.com/CRED-CLUB/propeller/rpc/push/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakePubSub struct {
+	pubsub.IPubSub
+	published []pubsub.PublishRequest
+	err       error
+}
+
+func (f *fakePubSub) Publish(ctx context.Context, request pubsub.PublishRequest) error {
+	f.published = append(f.published, request)
+	return f.err
+}
+
+func getUpstreamEvent() *pushv1.UpstreamEvent {
+	return &pushv1.UpstreamEvent{
+		ClientId: "client1",
+		DeviceId: "device1",
+		ChannelEvent: &pushv1.ChannelEvent{
+			UniqueId: "id1",
+			Event:    &pushv1.Event{Name: "telemetry"},
+		},
+	}
+}
+
+func TestRouter_Route(t *testing.T) {
+	_, err := logger.NewLogger("dev", nil, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("publishes to broker", func(t *testing.T) {
+		ps := &fakePubSub{}
+		r := NewRouter(ps, Config{Enabled: true})
+
+		err := r.Route(ctx, getUpstreamEvent())
+		assert.NoError(t, err)
+		assert.Len(t, ps.published, 1)
+		assert.Equal(t, "upstream.telemetry", ps.published[0].Channel)
+		got := &pushv1.UpstreamEvent{}
+		assert.NoError(t, proto.Unmarshal(ps.published[0].Data, got))
+		assert.Equal(t, "client1", got.ClientId)
+		assert.Equal(t, "device1", got.DeviceId)
+	})
+
+	t.Run("custom subject prefix", func(t *testing.T) {
+		ps := &fakePubSub{}
+		r := NewRouter(ps, Config{Enabled: true, SubjectPrefix: "in"})
+
+		err := r.Route(ctx, getUpstreamEvent())
+		assert.NoError(t, err)
+		assert.Equal(t, "in.telemetry", ps.published[0].Channel)
+	})
+
+	t.Run("forwards to http endpoint", func(t *testing.T) {
+		received := make(chan *pushv1.UpstreamEvent, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			body, _ := io.ReadAll(req.Body)
+			event := &pushv1.UpstreamEvent{}
+			_ = protojson.Unmarshal(body, event)
+			received <- event
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+		r := NewRouter(&fakePubSub{}, Config{Enabled: true, HTTPEndpoint: srv.URL})
+
+		err := r.Route(ctx, getUpstreamEvent())
+		assert.NoError(t, err)
+		select {
+		case event := <-received:
+			assert.Equal(t, "telemetry", event.GetChannelEvent().GetEvent().GetName())
+		case <-time.After(time.Second):
+			t.Fatal("event not forwarded")
+		}
+	})
+
+	t.Run("http endpoint failure", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+		r := NewRouter(&fakePubSub{}, Config{Enabled: true, HTTPEndpoint: srv.URL, EventNames: []string{"failing"}})
+		event := getUpstreamEvent()
+		event.ChannelEvent.Event.Name = "failing"
+
+		failed := testutil.ToFloat64(forwardsFailed.WithLabelValues("failing"))
+		err := r.Route(ctx, event)
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			return testutil.ToFloat64(forwardsFailed.WithLabelValues("failing")) == failed+1
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("slow http endpoint does not block", func(t *testing.T) {
+		release := make(chan struct{})
+		var once sync.Once
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			<-release
+		}))
+		defer srv.Close()
+		defer once.Do(func() { close(release) })
+		r := NewRouter(&fakePubSub{}, Config{Enabled: true, HTTPEndpoint: srv.URL})
+
+		done := make(chan error, 1)
+		go func() { done <- r.Route(ctx, getUpstreamEvent()) }()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("route blocked on the http endpoint")
+		}
+		once.Do(func() { close(release) })
+	})
+
+	t.Run("too many pending forwards", func(t *testing.T) {
+		ps := &fakePubSub{}
+		r := NewRouter(ps, Config{Enabled: true, HTTPEndpoint: "http://localhost"})
+		for i := 0; i < maxPendingForwards; i++ {
+			r.pendingForwards <- struct{}{}
+		}
+
+		err := r.Route(ctx, getUpstreamEvent())
+		assert.Error(t, err)
+		assert.Empty(t, ps.published)
+	})
+
+	t.Run("invalid event names", func(t *testing.T) {
+		ps := &fakePubSub{}
+		r := NewRouter(ps, Config{Enabled: true})
+
+		for _, name := range []string{"", "telemetry.*", "telemetry.>", "a b", ">"} {
+			event := getUpstreamEvent()
+			event.ChannelEvent.Event.Name = name
+			assert.Error(t, r.Route(ctx, event), name)
+		}
+		assert.Empty(t, ps.published)
+	})
+
+	t.Run("event names not allowed", func(t *testing.T) {
+		ps := &fakePubSub{}
+		r := NewRouter(ps, Config{Enabled: true, EventNames: []string{"telemetry"}})
+
+		assert.NoError(t, r.Route(ctx, getUpstreamEvent()))
+		event := getUpstreamEvent()
+		event.ChannelEvent.Event.Name = "other_event"
+		assert.Error(t, r.Route(ctx, event))
+		assert.Len(t, ps.published, 1)
+	})
+
+	t.Run("metrics label", func(t *testing.T) {
+		r := NewRouter(&fakePubSub{}, Config{Enabled: true, EventNames: []string{"telemetry"}})
+		assert.Equal(t, "telemetry", r.metricsLabel("telemetry"))
+		assert.Equal(t, "other", r.metricsLabel("a_b"))
+
+		r = NewRouter(&fakePubSub{}, Config{Enabled: true})
+		assert.Equal(t, "other", r.metricsLabel("telemetry"))
+	})
+
+	t.Run("broker failure", func(t *testing.T) {
+		ps := &fakePubSub{err: assert.AnError}
+		r := NewRouter(ps, Config{Enabled: true})
+
+		err := r.Route(ctx, getUpstreamEvent())
+		assert.Error(t, err)
+	})
+}
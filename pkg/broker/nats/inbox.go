@@ -0,0 +1,104 @@
+package natspkg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/internal/perror"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Inbox is a JetStream stream which holds a bounded list of messages per key
+type Inbox struct {
+	js     jetstream.JetStream
+	stream jetstream.Stream
+	name   string
+}
+
+// CreateInbox for NATS, messages beyond maxLen per key or older than maxAge are discarded
+func (j *JetStream) CreateInbox(ctx context.Context, name string, maxLen int64, maxAge time.Duration) (*Inbox, error) {
+	stream, err := j.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:              name,
+		Subjects:          []string{fmt.Sprintf("%s.>", name)},
+		MaxMsgsPerSubject: maxLen,
+		MaxAge:            maxAge,
+		Discard:           jetstream.DiscardOld,
+	})
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error creating nats inbox %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	return &Inbox{js: j.js, stream: stream, name: name}, nil
+}
+
+// Append a message for a key
+func (i *Inbox) Append(ctx context.Context, key string, data []byte) error {
+	_, err := i.js.Publish(ctx, i.subject(key), data)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error appending to nats inbox %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return nil
+}
+
+// Drain returns all messages for a key in order and removes them
+func (i *Inbox) Drain(ctx context.Context, key string) ([][]byte, error) {
+	subject := i.subject(key)
+	info, err := i.stream.Info(ctx, jetstream.WithSubjectFilter(subject))
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error getting nats inbox info %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	count := info.State.Subjects[subject]
+	if count == 0 {
+		return nil, nil
+	}
+
+	consumer, err := i.stream.OrderedConsumer(ctx, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+	})
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error creating nats inbox consumer %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	batch, err := consumer.FetchNoWait(int(count))
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error fetching from nats inbox %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+
+	var messages [][]byte
+	var lastSeq uint64
+	for msg := range batch.Messages() {
+		messages = append(messages, msg.Data())
+		metadata, err := msg.Metadata()
+		if err == nil {
+			lastSeq = metadata.Sequence.Stream
+		}
+	}
+	if batch.Error() != nil {
+		pErr := perror.Newf(perror.Internal, "error fetching from nats inbox %v", batch.Error())
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+
+	// purge only what was read, messages appended meanwhile are kept
+	err = i.stream.Purge(ctx, jetstream.WithPurgeSubject(subject), jetstream.WithPurgeSequence(lastSeq+1))
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error purging nats inbox %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	return messages, nil
+}
+
+func (i *Inbox) subject(key string) string {
+	return fmt.Sprintf("%s.%s", i.name, key)
+}
@@ -2,7 +2,10 @@ package natspkg
 
 import (
 	"context"
+	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/CRED-CLUB/propeller/pkg/broker"
 
@@ -11,43 +14,43 @@ import (
 	"github.com/nats-io/nats.go/jetstream"
 )
 
+// maxAdvanceBatch is the number of messages of the durable consumer acked at a time when advancing it
+const maxAdvanceBatch = 100
+
 // JetStream ...
 type JetStream struct {
 	c         *Client
 	js        jetstream.JetStream
 	streamMap sync.Map
 	ctx       context.Context
+	maxAge    time.Duration
 }
 
-// NewJetStream returns nats NewJetStream
-func NewJetStream(ctx context.Context, c *Client) (*JetStream, error) {
+// NewJetStream returns nats NewJetStream, maxAge limits the age of events retained by
+// streams created for channels, 0 means no limit
+func NewJetStream(ctx context.Context, c *Client, maxAge time.Duration) (*JetStream, error) {
 	js, err := jetstream.New(c.conn)
 	if err != nil {
 		pErr := perror.Newf(perror.Internal, "unable to create JetStream %s", err)
 		logger.Ctx(ctx).Error(pErr.Error())
 		return nil, pErr
 	}
-	return &JetStream{c, js, sync.Map{}, ctx}, nil
+	return &JetStream{c: c, js: js, ctx: ctx, maxAge: maxAge}, nil
 }
 
 // Publish data to the subject
 func (j *JetStream) Publish(ctx context.Context, request PublishRequest) error {
-	streamConfig := jetstream.StreamConfig{
-		Name:     request.Channel,
-		Subjects: []string{request.Channel},
-	}
-	_, ok := j.streamMap.Load(request.Channel)
-	if !ok {
-		stream, err := j.js.CreateStream(ctx, streamConfig)
-		if err != nil {
-			pErr := perror.Newf(perror.Internal, "unable to create JetStream stream: %s", err)
-			logger.Ctx(ctx).Error(pErr.Error())
-			return pErr
-		}
-		j.streamMap.Store(request.Channel, stream)
+	err := j.createStreamIfNotExists(ctx, request.Channel)
+	if err != nil {
+		return err
 	}
 
-	_, err := j.js.Publish(ctx, request.Channel, request.Data)
+	var opts []jetstream.PublishOpt
+	if request.ID != "" {
+		// sets Nats-Msg-Id, a message with the same id is dropped within the duplicate window of the stream
+		opts = append(opts, jetstream.WithMsgID(request.ID))
+	}
+	_, err = j.js.Publish(ctx, request.Channel, request.Data, opts...)
 	if err != nil {
 		pErr := perror.Newf(perror.Internal, "unable to publish JetStream stream: %s", err)
 		logger.Ctx(ctx).Error(pErr.Error())
@@ -58,27 +61,19 @@ func (j *JetStream) Publish(ctx context.Context, request PublishRequest) error {
 
 // Subscribe to jetstream topic
 func (j *JetStream) Subscribe(ctx context.Context, channel string) (broker.ISubscription, error) {
-	js := &JetStreamSubscription{
-		BaseSubscription: broker.BaseSubscription{
-			make(chan broker.TopicEvent),
-			[]string{channel},
-		},
-		JetStream: *j,
+	err := j.createStreamIfNotExists(ctx, channel)
+	if err != nil {
+		return nil, err
 	}
-	_, ok := j.streamMap.Load(channel)
-	if !ok {
-		streamConfig := jetstream.StreamConfig{
-			Name:     channel,
-			Subjects: []string{channel},
-		}
-		stream, err := j.js.CreateStream(ctx, streamConfig)
-		if err != nil {
-			pErr := perror.Newf(perror.Internal, "unable to create JetStream stream: %s", err)
-			logger.Ctx(ctx).Error(pErr.Error())
-			return nil, pErr
-		}
-		j.streamMap.Store(channel, stream)
+	consumer, err := j.durableConsumer(ctx, channel)
+	if err != nil {
+		return nil, err
 	}
+	return j.consume(ctx, channel, consumer, true)
+}
+
+// durableConsumer returns the consumer shared by the subscriptions of the channel without a cursor
+func (j *JetStream) durableConsumer(ctx context.Context, channel string) (jetstream.Consumer, error) {
 	consumerConfig := jetstream.ConsumerConfig{
 		Durable:   channel,
 		AckPolicy: jetstream.AckExplicitPolicy,
@@ -89,6 +84,103 @@ func (j *JetStream) Subscribe(ctx context.Context, channel string) (broker.ISubs
 		logger.Ctx(ctx).Error(pErr.Error())
 		return nil, pErr
 	}
+	return consumer, nil
+}
+
+// advanceDurableConsumer acks the messages of the durable consumer up to the stream sequence, so that
+// events delivered to a resumed subscription are not delivered again to a subscription without a cursor
+func (j *JetStream) advanceDurableConsumer(ctx context.Context, channel string, seq uint64) error {
+	consumer, err := j.durableConsumer(ctx, channel)
+	if err != nil {
+		return err
+	}
+	for {
+		batch, err := consumer.FetchNoWait(maxAdvanceBatch)
+		if err != nil {
+			pErr := perror.Newf(perror.Internal, "unable to fetch from JetStream consumer: %s", err)
+			logger.Ctx(ctx).Error(pErr.Error())
+			return pErr
+		}
+		fetched, advanced := 0, true
+		for msg := range batch.Messages() {
+			fetched++
+			metadata, err := msg.Metadata()
+			// later messages are left to be redelivered
+			if err != nil || metadata.Sequence.Stream > seq {
+				advanced = false
+				_ = msg.Nak()
+				continue
+			}
+			err = msg.Ack()
+			if err != nil {
+				pErr := perror.Newf(perror.Internal, "unable to ack JetStream msg: %s", err)
+				logger.Ctx(ctx).Error(pErr.Error())
+				return pErr
+			}
+		}
+		if fetched == 0 || !advanced {
+			return nil
+		}
+	}
+}
+
+// SubscribeFrom subscribes to jetstream topic and receives events after the cursor,
+// cursor is the stream sequence of the last event received
+func (j *JetStream) SubscribeFrom(ctx context.Context, channel string, cursor string) (broker.ISubscription, error) {
+	seq, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil {
+		pErr := perror.Newf(perror.InvalidArgument, "invalid cursor %s", cursor)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	err = j.createStreamIfNotExists(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	// an ordered consumer is private to the subscriber, unlike the durable consumer shared by channel
+	consumerConfig := jetstream.OrderedConsumerConfig{
+		DeliverPolicy: jetstream.DeliverByStartSequencePolicy,
+		OptStartSeq:   seq + 1,
+	}
+	consumer, err := j.js.OrderedConsumer(ctx, channel, consumerConfig)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to create JetStream consumer: %s", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	return j.consume(ctx, channel, consumer, false)
+}
+
+func (j *JetStream) createStreamIfNotExists(ctx context.Context, channel string) error {
+	_, ok := j.streamMap.Load(channel)
+	if ok {
+		return nil
+	}
+	streamConfig := jetstream.StreamConfig{
+		Name:     channel,
+		Subjects: []string{channel},
+		MaxAge:   j.maxAge,
+	}
+	// update so that a change in retention applies to existing streams
+	stream, err := j.js.CreateOrUpdateStream(ctx, streamConfig)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "unable to create JetStream stream: %s", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	j.streamMap.Store(channel, stream)
+	return nil
+}
+
+func (j *JetStream) consume(ctx context.Context, channel string, consumer jetstream.Consumer, ackRequired bool) (broker.ISubscription, error) {
+	js := &JetStreamSubscription{
+		BaseSubscription: broker.BaseSubscription{
+			TopicEventChan: make(chan broker.TopicEvent),
+			Topics:         []string{channel},
+		},
+		JetStream:   j,
+		ackRequired: ackRequired,
+	}
 	c, err := consumer.Consume(js.jetStreamMessageHandler)
 	if err != nil {
 		pErr := perror.Newf(perror.Internal, "unable to consume from JetStream: %s", err)
@@ -99,11 +191,15 @@ func (j *JetStream) Subscribe(ctx context.Context, channel string) (broker.ISubs
 	return js, nil
 }
 
-// UnSubscribe from jetstream topic
+// UnSubscribe from jetstream topic, the durable consumer of the channel is advanced past the events
+// delivered to a resumed subscription
 func (j *JetStream) UnSubscribe(ctx context.Context, s broker.ISubscription) error {
 	switch t := s.(type) {
 	case *JetStreamSubscription:
 		t.consumeContext.Stop()
+		if seq := t.lastSeq.Load(); !t.ackRequired && seq > 0 {
+			return j.advanceDurableConsumer(ctx, t.Topics[0], seq)
+		}
 	default:
 		pErr := perror.Newf(perror.Internal, "invalid subscription type: %T", t)
 		logger.Ctx(ctx).Error(pErr.Error())
@@ -115,8 +211,11 @@ func (j *JetStream) UnSubscribe(ctx context.Context, s broker.ISubscription) err
 // JetStreamSubscription ...
 type JetStreamSubscription struct {
 	broker.BaseSubscription
-	JetStream
+	*JetStream
 	consumeContext jetstream.ConsumeContext
+	ackRequired    bool
+	// lastSeq is the stream sequence of the last event delivered to a resumed subscription
+	lastSeq atomic.Uint64
 }
 
 func (j *JetStreamSubscription) jetStreamMessageHandler(msg jetstream.Msg) {
@@ -124,8 +223,18 @@ func (j *JetStreamSubscription) jetStreamMessageHandler(msg jetstream.Msg) {
 		Event: msg.Data(),
 		Topic: msg.Subject(),
 	}
+	metadata, err := msg.Metadata()
+	if err == nil {
+		te.ID = strconv.FormatUint(metadata.Sequence.Stream, 10)
+	}
 	j.TopicEventChan <- te
-	err := msg.Ack()
+	if !j.ackRequired {
+		if metadata != nil {
+			j.lastSeq.Store(metadata.Sequence.Stream)
+		}
+		return
+	}
+	err = msg.Ack()
 	if err != nil {
 		pErr := perror.Newf(perror.Internal, "unable to ack JetStream msg: %s", err)
 		logger.Ctx(j.ctx).Error(pErr.Error())
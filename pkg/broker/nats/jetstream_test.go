@@ -0,0 +1,68 @@
+package natspkg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CRED-CLUB/propeller/pkg/broker"
+	"github.com/CRED-CLUB/propeller/pkg/logger"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestJetStream(t *testing.T) *JetStream {
+	_, err := logger.NewLogger("dev", nil, nil)
+	assert.NoError(t, err)
+	ns, err := server.NewServer(&server.Options{JetStream: true, Port: -1, StoreDir: t.TempDir()})
+	assert.NoError(t, err)
+	go ns.Start()
+	t.Cleanup(ns.Shutdown)
+	assert.True(t, ns.ReadyForConnections(5*time.Second))
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, Config{URL: ns.ClientURL()})
+	assert.NoError(t, err)
+	js, err := NewJetStream(ctx, c, 0)
+	assert.NoError(t, err)
+	return js
+}
+
+func receive(t *testing.T, s broker.ISubscription) broker.TopicEvent {
+	select {
+	case te := <-s.GetTopicEventChan():
+		return te
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return broker.TopicEvent{}
+}
+
+func TestJetStream_SubscribeFrom(t *testing.T) {
+	js := newTestJetStream(t)
+	ctx := context.Background()
+
+	for _, data := range []string{"e1", "e2", "e3"} {
+		assert.NoError(t, js.Publish(ctx, PublishRequest{Channel: "client1", Data: []byte(data)}))
+	}
+
+	// resume after the first event
+	resumed, err := js.SubscribeFrom(ctx, "client1", "1")
+	assert.NoError(t, err)
+	for _, want := range []string{"e2", "e3"} {
+		assert.Equal(t, want, string(receive(t, resumed).Event))
+	}
+	assert.Eventually(t, func() bool {
+		return resumed.(*JetStreamSubscription).lastSeq.Load() == 3
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.NoError(t, js.UnSubscribe(ctx, resumed))
+
+	// the events delivered to the resumed subscription are not delivered again without a cursor
+	assert.NoError(t, js.Publish(ctx, PublishRequest{Channel: "client1", Data: []byte("e4")}))
+	subscription, err := js.Subscribe(ctx, "client1")
+	assert.NoError(t, err)
+	te := receive(t, subscription)
+	assert.Equal(t, "e4", string(te.Event))
+	assert.Equal(t, "4", te.ID)
+	assert.NoError(t, js.UnSubscribe(ctx, subscription))
+}
@@ -2,6 +2,8 @@ package natspkg
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/CRED-CLUB/propeller/internal/perror"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
@@ -13,14 +15,14 @@ type KV struct {
 	kv jetstream.KeyValue
 }
 
-// CreateKeyValue for NATS
-func (j *JetStream) CreateKeyValue(ctx context.Context, bucket string) (*KV, error) {
+// CreateKeyValue for NATS, keys of the bucket expire ttl after they are last written, 0 means no expiry
+func (j *JetStream) CreateKeyValue(ctx context.Context, bucket string, ttl time.Duration) (*KV, error) {
 	kv, err := j.js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
 		Bucket:       bucket,
 		Description:  "",
 		MaxValueSize: 0,
 		History:      0,
-		TTL:          0,
+		TTL:          ttl,
 		MaxBytes:     0,
 		Storage:      0,
 		Replicas:     0,
@@ -43,6 +45,44 @@ func (kv *KV) Put(ctx context.Context, key string, value []byte) error {
 	return err
 }
 
+// Create a key with value if it does not exist, returns false if the key exists
+func (kv *KV) Create(ctx context.Context, key string, value []byte) (bool, error) {
+	_, err := kv.kv.Create(ctx, key, value)
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return false, nil
+	}
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error creating nats key %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return false, pErr
+	}
+	return true, nil
+}
+
+// Update value for a key if its revision is unchanged, returns false if the key was modified since
+func (kv *KV) Update(ctx context.Context, key string, value []byte, revision uint64) (bool, error) {
+	_, err := kv.kv.Update(ctx, key, value, revision)
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return false, nil
+	}
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error updating nats key %v", err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return false, pErr
+	}
+	return true, nil
+}
+
+// GetWithRevision returns value and revision for a key
+func (kv *KV) GetWithRevision(ctx context.Context, key string) ([]byte, uint64, error) {
+	entry, err := kv.kv.Get(ctx, key)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error getting nats key %v", err)
+		return nil, 0, pErr
+	}
+	return entry.Value(), entry.Revision(), nil
+}
+
 // Get value for a key
 func (kv *KV) Get(ctx context.Context, key string) ([]byte, error) {
 	entry, err := kv.kv.Get(ctx, key)
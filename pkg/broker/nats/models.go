@@ -4,4 +4,6 @@ package natspkg
 type PublishRequest struct {
 	Channel string
 	Data    []byte
+	// ID deduplicates the event in JetStream, optional
+	ID string
 }
@@ -14,6 +14,7 @@ import (
 type INats interface {
 	Publish(ctx context.Context, publishRequest PublishRequest) error
 	Subscribe(ctx context.Context, channel string) (broker.ISubscription, error)
+	SubscribeFrom(ctx context.Context, channel string, cursor string) (broker.ISubscription, error)
 	UnSubscribe(ctx context.Context, s broker.ISubscription) error
 }
 
@@ -73,6 +73,11 @@ func (s PubSub) Subscribe(ctx context.Context, subject string) (broker.ISubscrip
 	return ps, nil
 }
 
+// SubscribeFrom subscribes to a subject, cursor is ignored as events are not persisted
+func (s PubSub) SubscribeFrom(ctx context.Context, subject string, cursor string) (broker.ISubscription, error) {
+	return s.Subscribe(ctx, subject)
+}
+
 // NewEmbeddedServer start an embedded NATS server for stage/testing
 func NewEmbeddedServer(ctx context.Context) (string, error) {
 	opts := &server.Options{JetStream: true}
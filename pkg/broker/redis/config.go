@@ -6,4 +6,6 @@ type Config struct {
 	Password           string
 	TLSEnabled         bool
 	ClusterModeEnabled bool
+	// StreamMaxLen caps the number of events retained per stream when persistence is enabled, 0 means uncapped
+	StreamMaxLen int64
 }
@@ -2,6 +2,8 @@ package redispkg
 
 import (
 	"context"
+	"sort"
+	"sync"
 
 	"github.com/CRED-CLUB/propeller/pkg/broker"
 
@@ -61,15 +63,35 @@ func (p PubSub) Subscribe(ctx context.Context, channel ...string) broker.ISubscr
 			TopicEventChan: make(chan broker.TopicEvent),
 			Topics:         channel,
 		},
-		subs: s,
+		subs:     s,
+		patterns: newPatternSet(),
 	}
 	go pubSubSubscription.start(ctx)
 	return pubSubSubscription
 }
 
-// AddSubscription to a redis pubsub channel
+// SubscribeFrom subscribes to a redis pubsub channel, cursor is ignored as events are not persisted
+func (p PubSub) SubscribeFrom(ctx context.Context, channel string, cursor string) broker.ISubscription {
+	return p.Subscribe(ctx, channel)
+}
+
+// AddSubscription to a redis pubsub channel, a wildcard channel is subscribed with PSUBSCRIBE
 func (p PubSub) AddSubscription(ctx context.Context, channel string, s broker.ISubscription) error {
 	PubSubSubscription := s.(PubSubSubscription)
+	if broker.IsWildcard(channel) {
+		glob, first := PubSubSubscription.patterns.add(channel)
+		if !first {
+			return nil
+		}
+		err := PubSubSubscription.subs.PSubscribe(ctx, glob)
+		if err != nil {
+			PubSubSubscription.patterns.remove(channel)
+			pErr := perror.Newf(perror.Internal, "unable to add subscription of pattern %s with error %w", channel, err)
+			logger.Ctx(ctx).Error(pErr.Error())
+			return pErr
+		}
+		return nil
+	}
 	err := PubSubSubscription.subs.Subscribe(ctx, channel)
 	if err != nil {
 		pErr := perror.Newf(perror.Internal, "unable to add subscription of channel %s with error %w", channel, err)
@@ -82,6 +104,19 @@ func (p PubSub) AddSubscription(ctx context.Context, channel string, s broker.IS
 // RemoveSubscription removes a subscription
 func (p PubSub) RemoveSubscription(ctx context.Context, channel string, s broker.ISubscription) error {
 	PubSubSubscription := s.(PubSubSubscription)
+	if broker.IsWildcard(channel) {
+		glob, last := PubSubSubscription.patterns.remove(channel)
+		if !last {
+			return nil
+		}
+		err := PubSubSubscription.subs.PUnsubscribe(ctx, glob)
+		if err != nil {
+			pErr := perror.Newf(perror.Internal, "unable to unsubscribe pattern %s with error %w", channel, err)
+			logger.Ctx(ctx).Error(pErr.Error())
+			return pErr
+		}
+		return nil
+	}
 	err := PubSubSubscription.subs.Unsubscribe(ctx, channel)
 	if err != nil {
 		pErr := perror.Newf(perror.Internal, "unable to unsubscribe subscription %s with error %w", channel, err)
@@ -112,21 +147,92 @@ func (p PubSub) UnSubscribe(ctx context.Context, s broker.ISubscription) error {
 // PubSubSubscription provides pubsub subscription
 type PubSubSubscription struct {
 	broker.BaseSubscription
-	subs *redis.PubSub
+	subs     *redis.PubSub
+	patterns *patternSet
 }
 
 func (p PubSubSubscription) start(ctx context.Context) {
 	for {
 		select {
 		case msg := <-p.subs.Channel():
-			te := broker.TopicEvent{
-				Event: []byte(msg.Payload),
-				Topic: msg.Channel,
+			if msg.Pattern == "" {
+				p.TopicEventChan <- broker.TopicEvent{
+					Event: []byte(msg.Payload),
+					Topic: msg.Channel,
+				}
+				break
+			}
+			// the glob of a pattern matches more subjects than the pattern itself, and
+			// the event is received once for all patterns of the glob
+			for _, pattern := range p.patterns.matching(msg.Pattern, msg.Channel) {
+				p.TopicEventChan <- broker.TopicEvent{
+					Event:   []byte(msg.Payload),
+					Topic:   msg.Channel,
+					Subject: pattern,
+				}
 			}
-			p.TopicEventChan <- te
 		case <-ctx.Done():
 			logger.Ctx(ctx).Debug("stopping redis subscription")
 			return
 		}
 	}
 }
+
+// patternSet holds the wildcard patterns of a subscription by their redis glob,
+// as different patterns can map to the same glob
+type patternSet struct {
+	mu     sync.RWMutex
+	byGlob map[string]map[string]struct{}
+}
+
+func newPatternSet() *patternSet {
+	return &patternSet{byGlob: make(map[string]map[string]struct{})}
+}
+
+// add returns the glob of the pattern, first is true if the glob is to be subscribed
+func (ps *patternSet) add(pattern string) (glob string, first bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	glob = broker.ToGlob(pattern)
+	patterns, ok := ps.byGlob[glob]
+	if !ok {
+		patterns = make(map[string]struct{})
+		ps.byGlob[glob] = patterns
+	}
+	patterns[pattern] = struct{}{}
+	return glob, !ok
+}
+
+// remove returns the glob of the pattern, last is true if the glob is to be unsubscribed
+func (ps *patternSet) remove(pattern string) (glob string, last bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	glob = broker.ToGlob(pattern)
+	patterns, ok := ps.byGlob[glob]
+	if !ok {
+		return glob, false
+	}
+	delete(patterns, pattern)
+	if len(patterns) > 0 {
+		return glob, false
+	}
+	delete(ps.byGlob, glob)
+	return glob, true
+}
+
+// matching returns the sorted patterns of the glob which match the subject received for it
+func (ps *patternSet) matching(glob string, subject string) []string {
+	if ps == nil {
+		return nil
+	}
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	var patterns []string
+	for pattern := range ps.byGlob[glob] {
+		if broker.MatchSubject(pattern, subject) {
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Strings(patterns)
+	return patterns
+}
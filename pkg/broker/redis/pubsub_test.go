@@ -3,6 +3,7 @@ package redispkg
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/CRED-CLUB/propeller/pkg/broker"
 	"github.com/CRED-CLUB/propeller/pkg/logger"
@@ -180,3 +181,46 @@ func TestPubSub_UnSubscribe(t *testing.T) {
 	err = ps.UnSubscribe(context.Background(), subscription)
 	assert.NoError(t, err)
 }
+
+func TestPubSub_AddWildcardSubscription(t *testing.T) {
+	serviceKV := map[string]interface{}{
+		"serviceName":   "test-service",
+		"gitCommitHash": "test-hash",
+	}
+	_, err := logger.NewLogger("dev", serviceKV, nil)
+	assert.NoError(t, err)
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ps := &PubSub{
+		c: &Client{client: client},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscription := ps.Subscribe(ctx, "test-channel")
+	err = ps.AddSubscription(ctx, "orders.*", subscription)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return mr.PubSubNumPat() == 1 }, time.Second, 10*time.Millisecond)
+
+	// matches the glob orders.* but not the pattern as it has two more tokens
+	err = ps.Publish(ctx, PublishRequest{Channel: "orders.eu.created", Data: []byte("skipped")})
+	assert.NoError(t, err)
+	err = ps.Publish(ctx, PublishRequest{Channel: "orders.created", Data: []byte("data")})
+	assert.NoError(t, err)
+
+	te := <-subscription.(PubSubSubscription).TopicEventChan
+	assert.Equal(t, "orders.created", te.Topic)
+	assert.Equal(t, "orders.*", te.Subject)
+	assert.Equal(t, []byte("data"), te.Event)
+
+	err = ps.RemoveSubscription(ctx, "orders.*", subscription)
+	assert.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package redispkg
 import (
 	"context"
 	"crypto/tls"
+	"time"
 
 	"github.com/CRED-CLUB/propeller/pkg/broker"
 
@@ -16,6 +17,7 @@ type IRedis interface {
 	Publish(ctx context.Context, publishRequest PublishRequest) error
 	PublishBulk(ctx context.Context, publishRequest []PublishRequest) error
 	Subscribe(ctx context.Context, channel ...string) broker.ISubscription
+	SubscribeFrom(ctx context.Context, channel string, cursor string) broker.ISubscription
 	UnSubscribe(ctx context.Context, s broker.ISubscription) error
 	AddSubscription(ctx context.Context, channel string, s broker.ISubscription) error
 	RemoveSubscription(ctx context.Context, channel string, s broker.ISubscription) error
@@ -121,6 +123,26 @@ func (c *Client) HGetAll(ctx context.Context, key string) (map[string]string, er
 	return v, nil
 }
 
+// HGetAllBulk returns all values map for each of the keys in a single round trip
+func (c *Client) HGetAllBulk(ctx context.Context, keys ...string) ([]map[string]string, error) {
+	pipe := c.client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.HGetAll(ctx, key)
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error in redis hash get all of %d keys %+v", len(keys), err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	values := make([]map[string]string, len(keys))
+	for i, cmd := range cmds {
+		values[i] = cmd.Val()
+	}
+	return values, nil
+}
+
 // Delete a field for a key
 func (c *Client) Delete(ctx context.Context, key string, fields ...string) error {
 	err := c.client.HDel(ctx, key, fields...).Err()
@@ -131,3 +153,58 @@ func (c *Client) Delete(ctx context.Context, key string, fields ...string) error
 	}
 	return nil
 }
+
+// SetNX sets key with value if it does not exist, returns false if the key exists
+func (c *Client) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	ok, err := c.client.SetNX(ctx, key, value, expiration).Result()
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error in redis set nx key:%v %+v", key, err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return false, pErr
+	}
+	return ok, nil
+}
+
+// Del deletes keys
+func (c *Client) Del(ctx context.Context, keys ...string) error {
+	err := c.client.Del(ctx, keys...).Err()
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error in redis del keys:%v %+v", keys, err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return nil
+}
+
+// RPushCapped appends values to a list, keeps at most maxLen latest values and sets expiration on the list
+func (c *Client) RPushCapped(ctx context.Context, key string, maxLen int64, expiration time.Duration, values ...interface{}) error {
+	pipe := c.client.TxPipeline()
+	pipe.RPush(ctx, key, values...)
+	if maxLen > 0 {
+		pipe.LTrim(ctx, key, -maxLen, -1)
+	}
+	if expiration > 0 {
+		pipe.Expire(ctx, key, expiration)
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		pErr := perror.Newf(perror.Internal, "error in redis list push key:%v %+v", key, err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return pErr
+	}
+	return nil
+}
+
+// LPopAll returns all values of a list in order and deletes the list
+func (c *Client) LPopAll(ctx context.Context, key string) ([]string, error) {
+	pipe := c.client.TxPipeline()
+	values := pipe.LRange(ctx, key, 0, -1)
+	pipe.Del(ctx, key)
+	_, err := pipe.Exec(ctx)
+	if err != nil && err != redis.Nil {
+		pErr := perror.Newf(perror.Internal, "error in redis list pop key:%v %+v", key, err)
+		logger.Ctx(ctx).Error(pErr.Error())
+		return nil, pErr
+	}
+	return values.Val(), nil
+}
@@ -3,6 +3,7 @@ package redispkg
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,22 +18,22 @@ import (
 type Streams struct {
 	c             *Client
 	cancelFuncMap map[string]context.CancelFunc
+	maxLen        int64
+	maxAge        time.Duration
 }
 
-// NewStreams returns redis Streams
-func NewStreams(c *Client) *Streams {
-	return &Streams{c, make(map[string]context.CancelFunc)}
+// NewStreams returns redis Streams, maxLen caps the length of each stream and maxAge
+// the age of events retained, 0 means no limit
+func NewStreams(c *Client, maxLen int64, maxAge time.Duration) *Streams {
+	return &Streams{c, make(map[string]context.CancelFunc), maxLen, maxAge}
 }
 
 // Publish message to redis
 func (ss Streams) Publish(ctx context.Context, request PublishRequest) error {
-	v := map[string]interface{}{"data": request.Data}
+	pipeline := ss.c.client.Pipeline()
+	ss.xAdd(ctx, pipeline, request)
 
-	err := ss.c.client.XAdd(ctx, &redis.XAddArgs{
-		Stream: fmt.Sprintf("%s-stream", request.Channel),
-		MaxLen: 0,
-		Values: v,
-	}).Err()
+	_, err := pipeline.Exec(ctx)
 	if err != nil {
 		pErr := perror.Newf(perror.Internal, "error in redis stream publish %w", err)
 		logger.Ctx(ctx).Error(pErr.Error())
@@ -47,18 +48,7 @@ func (ss Streams) PublishBulk(ctx context.Context, publishRequests []PublishRequ
 	pipeline := ss.c.client.Pipeline()
 
 	for _, request := range publishRequests {
-		v := map[string]interface{}{"data": request.Data}
-		err := pipeline.XAdd(ctx, &redis.XAddArgs{
-			Stream: fmt.Sprintf("%s-stream", request.Channel),
-			MaxLen: 0,
-			Values: v,
-		}).Err()
-
-		if err != nil {
-			pErr := perror.Newf(perror.Internal, "error in redis stream publish %w for request %+v", err, request)
-			logger.Ctx(ctx).Error(pErr.Error())
-		}
-
+		ss.xAdd(ctx, pipeline, request)
 	}
 
 	_, err := pipeline.Exec(ctx)
@@ -71,6 +61,22 @@ func (ss Streams) PublishBulk(ctx context.Context, publishRequests []PublishRequ
 	return nil
 }
 
+// xAdd queues the event on the pipeline, trimming the stream to the retention limits
+func (ss Streams) xAdd(ctx context.Context, pipeline redis.Pipeliner, request PublishRequest) {
+	stream := fmt.Sprintf("%s-stream", request.Channel)
+	pipeline.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		MaxLen: ss.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{"data": request.Data},
+	})
+	// XADD trims either by length or by id, entries older than maxAge are trimmed separately
+	if ss.maxAge > 0 {
+		minID := strconv.FormatInt(time.Now().Add(-ss.maxAge).UnixMilli(), 10)
+		pipeline.XTrimMinIDApprox(ctx, stream, minID, 0)
+	}
+}
+
 // Subscribe to a redis stream
 func (ss Streams) Subscribe(ctx context.Context, channel ...string) broker.ISubscription {
 	channels := make([]string, len(channel))
@@ -88,6 +94,21 @@ func (ss Streams) Subscribe(ctx context.Context, channel ...string) broker.ISubs
 	return StreamSubscription
 }
 
+// SubscribeFrom subscribes to a redis stream and receives events after the cursor,
+// cursor is the stream entry ID of the last event received
+func (ss Streams) SubscribeFrom(ctx context.Context, channel string, cursor string) broker.ISubscription {
+	stream := fmt.Sprintf("%s-stream", channel)
+	StreamSubscription := StreamSubscription{
+		Streams: ss,
+		BaseSubscription: broker.BaseSubscription{
+			TopicEventChan: make(chan broker.TopicEvent),
+			Topics:         []string{stream},
+		},
+	}
+	go StreamSubscription.resume(ctx, stream, cursor)
+	return StreamSubscription
+}
+
 // RemoveSubscription ...
 func (ss Streams) RemoveSubscription(ctx context.Context, channel string, s broker.ISubscription) error {
 	ss.cancelFuncMap[channel]()
@@ -115,14 +136,66 @@ type StreamSubscription struct {
 	broker.BaseSubscription
 }
 
+// advanceCursorScript sets the delivered cursor of a stream to the entry ID unless it is already at or
+// after it, so that resuming from an older cursor does not move it back
+var advanceCursorScript = redis.NewScript(`
+local function parse(id)
+	local ms, seq = string.match(id, "^(%d+)-(%d+)$")
+	return tonumber(ms), tonumber(seq)
+end
+local current = redis.call("GET", KEYS[1])
+if current then
+	local cms, cseq = parse(current)
+	local ms, seq = parse(ARGV[1])
+	if ms < cms or (ms == cms and seq <= cseq) then
+		return 0
+	end
+end
+redis.call("SET", KEYS[1], ARGV[1])
+return 1
+`)
+
+// cursorKey holds the ID of the last entry of the stream delivered to a subscription without a cursor
+func cursorKey(stream string) string {
+	return fmt.Sprintf("%s-cursor", stream)
+}
+
+// advanceCursor marks the entry as delivered, entries are kept till the stream is trimmed so that a
+// subscription can still be resumed from an earlier cursor
+func (st StreamSubscription) advanceCursor(ctx context.Context, stream string, id string) {
+	err := advanceCursorScript.Run(ctx, st.Streams.c.client, []string{cursorKey(stream)}, id).Err()
+	if err != nil {
+		logger.Ctx(ctx).Errorw("error in advancing stream cursor", "stream", stream, "err", err.Error())
+	}
+}
+
+// start reads the streams after the entries last delivered, so that events published while there was
+// no subscription are delivered once
 func (st StreamSubscription) start(ctx context.Context, channels ...string) {
+	lastIDs := make(map[string]string, len(channels))
+	for _, stream := range channels {
+		id, err := st.Streams.c.client.Get(ctx, cursorKey(stream)).Result()
+		if err != nil {
+			if err != redis.Nil {
+				logger.Ctx(ctx).Errorw("error in loading stream cursor", "stream", stream, "err", err.Error())
+				return
+			}
+			id = "0"
+		}
+		lastIDs[stream] = id
+	}
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
+			streams := make([]string, 0, 2*len(channels))
+			streams = append(streams, channels...)
+			for _, stream := range channels {
+				streams = append(streams, lastIDs[stream])
+			}
 			resultStreams, err := st.Streams.c.client.XRead(ctx, &redis.XReadArgs{
-				Streams: append(channels, "0"),
+				Streams: streams,
 				Count:   1,
 				Block:   60 * time.Second,
 			}).Result()
@@ -133,20 +206,60 @@ func (st StreamSubscription) start(ctx context.Context, channels ...string) {
 				logger.Ctx(ctx).Errorw("error is", "err", err)
 				return
 			}
-			for _, stream := range resultStreams[0].Messages {
-				var msg []byte
-				msg = []byte(stream.Values["data"].(string))
+			for _, result := range resultStreams {
+				for _, message := range result.Messages {
+					te := broker.TopicEvent{
+						Event: []byte(message.Values["data"].(string)),
+						Topic: result.Stream,
+						ID:    message.ID,
+					}
+					select {
+					case st.TopicEventChan <- te:
+						lastIDs[result.Stream] = message.ID
+					case <-ctx.Done():
+						return
+					}
+					st.advanceCursor(ctx, result.Stream, message.ID)
+				}
+			}
+		}
+	}
+}
+
+// resume reads the stream after the cursor, the delivered cursor is advanced as by start so that the
+// entries are not delivered again when the channel is subscribed without a cursor
+func (st StreamSubscription) resume(ctx context.Context, stream string, cursor string) {
+	lastID := cursor
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			resultStreams, err := st.Streams.c.client.XRead(ctx, &redis.XReadArgs{
+				Streams: []string{stream, lastID},
+				Count:   100,
+				Block:   60 * time.Second,
+			}).Result()
+			if err != nil {
+				if strings.Contains(fmt.Sprint(err), "redis: nil") {
+					continue
+				}
+				logger.Ctx(ctx).Errorw("error in reading stream", "err", err)
+				return
+			}
+			for _, message := range resultStreams[0].Messages {
 				te := broker.TopicEvent{
-					Event: msg,
+					Event: []byte(message.Values["data"].(string)),
 					Topic: resultStreams[0].Stream,
+					ID:    message.ID,
 				}
-				st.TopicEventChan <- te
-				for _, chh := range channels {
-					_, err := st.Streams.c.client.XDel(ctx, chh, stream.ID).Result()
-					if err != nil {
-						logger.Ctx(ctx).Infow("error deleting", "err", err.Error())
-					}
+				select {
+				case st.TopicEventChan <- te:
+					lastID = message.ID
+				case <-ctx.Done():
+					return
 				}
+				st.advanceCursor(ctx, stream, message.ID)
 			}
 		}
 	}
@@ -0,0 +1,107 @@
+package redispkg
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreams_SubscribeFrom(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ss := NewStreams(&Client{client: client}, 0, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, data := range []string{"e1", "e2", "e3"} {
+		err = ss.Publish(ctx, PublishRequest{Channel: "client", Data: []byte(data)})
+		assert.NoError(t, err)
+	}
+	entries, err := client.XRange(ctx, "client-stream", "-", "+").Result()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+
+	// resume after the first event
+	subscription := ss.SubscribeFrom(ctx, "client", entries[0].ID)
+	for _, want := range entries[1:] {
+		select {
+		case te := <-subscription.GetTopicEventChan():
+			assert.Equal(t, want.ID, te.ID)
+			assert.Equal(t, want.Values["data"], string(te.Event))
+			assert.Equal(t, "client-stream", te.Topic)
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+
+	// delivered entries are kept till the stream is trimmed, so that they can be resumed again
+	remaining, err := client.XRange(ctx, "client-stream", "-", "+").Result()
+	assert.NoError(t, err)
+	assert.Len(t, remaining, 3)
+	cancel()
+
+	// subscribing without a cursor only delivers the entries published after the resumed ones
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	err = ss.Publish(ctx, PublishRequest{Channel: "client", Data: []byte("e4")})
+	assert.NoError(t, err)
+	subscription = ss.Subscribe(ctx, "client")
+	var lastID string
+	select {
+	case te := <-subscription.GetTopicEventChan():
+		assert.Equal(t, "e4", string(te.Event))
+		lastID = te.ID
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	assert.Eventually(t, func() bool {
+		return client.Get(ctx, cursorKey("client-stream")).Val() == lastID
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// resuming from an earlier cursor does not move the delivered cursor back
+	subscription.(StreamSubscription).advanceCursor(ctx, "client-stream", entries[1].ID)
+	assert.Equal(t, lastID, client.Get(ctx, cursorKey("client-stream")).Val())
+}
+
+func TestStreams_PublishTrimsByAge(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ss := NewStreams(&Client{client: client}, 0, time.Minute)
+	ctx := context.Background()
+
+	// entry older than the retention
+	oldID := fmt.Sprintf("%d-0", time.Now().Add(-time.Hour).UnixMilli())
+	err = client.XAdd(ctx, &redis.XAddArgs{
+		Stream: "client-stream",
+		ID:     oldID,
+		Values: map[string]interface{}{"data": "old"},
+	}).Err()
+	assert.NoError(t, err)
+
+	err = ss.Publish(ctx, PublishRequest{Channel: "client", Data: []byte("new")})
+	assert.NoError(t, err)
+
+	entries, err := client.XRange(ctx, "client-stream", "-", "+").Result()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "new", entries[0].Values["data"])
+}
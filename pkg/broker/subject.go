@@ -0,0 +1,99 @@
+package broker
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// singleTokenWildcard matches exactly one token of a subject
+	singleTokenWildcard = "*"
+	// multiTokenWildcard matches one or more trailing tokens of a subject
+	multiTokenWildcard = ">"
+	tokenSeparator     = "."
+)
+
+// IsWildcard checks if the subject is a pattern with wildcard tokens, e.g. orders.* or prices.>
+func IsWildcard(subject string) bool {
+	for _, token := range strings.Split(subject, tokenSeparator) {
+		if token == singleTokenWildcard || token == multiTokenWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateWildcard checks that the pattern has no empty token and > only as the last token
+func ValidateWildcard(pattern string) error {
+	tokens := strings.Split(pattern, tokenSeparator)
+	for i, token := range tokens {
+		if token == "" {
+			return fmt.Errorf("empty token in pattern %s", pattern)
+		}
+		if token == multiTokenWildcard && i != len(tokens)-1 {
+			return fmt.Errorf("%s must be the last token in pattern %s", multiTokenWildcard, pattern)
+		}
+	}
+	return nil
+}
+
+// MatchSubject checks if the subject matches the pattern, a subject matches itself
+func MatchSubject(pattern string, subject string) bool {
+	patternTokens := strings.Split(pattern, tokenSeparator)
+	subjectTokens := strings.Split(subject, tokenSeparator)
+	for i, token := range patternTokens {
+		if token == multiTokenWildcard {
+			return i < len(subjectTokens)
+		}
+		if i >= len(subjectTokens) {
+			return false
+		}
+		if token != singleTokenWildcard && token != subjectTokens[i] {
+			return false
+		}
+	}
+	return len(patternTokens) == len(subjectTokens)
+}
+
+// ToGlob converts the pattern to a glob style pattern as used by redis PSUBSCRIBE.
+// The glob matches a superset of the subjects matched by the pattern, as * in a glob
+// also matches the separator, so matches are to be filtered with MatchSubject.
+func ToGlob(pattern string) string {
+	tokens := strings.Split(pattern, tokenSeparator)
+	for i, token := range tokens {
+		if token == singleTokenWildcard || token == multiTokenWildcard {
+			tokens[i] = "*"
+			continue
+		}
+		tokens[i] = EscapeGlob(token)
+	}
+	return strings.Join(tokens, tokenSeparator)
+}
+
+// LiteralPrefix returns the part of the pattern before its first wildcard token
+func LiteralPrefix(pattern string) string {
+	tokens := strings.Split(pattern, tokenSeparator)
+	for i, token := range tokens {
+		if token != singleTokenWildcard && token != multiTokenWildcard {
+			continue
+		}
+		if i == 0 {
+			return ""
+		}
+		return strings.Join(tokens[:i], tokenSeparator) + tokenSeparator
+	}
+	return pattern
+}
+
+// EscapeGlob escapes the meta characters of a glob so that the token matches only itself
+func EscapeGlob(token string) string {
+	var sb strings.Builder
+	for _, r := range token {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
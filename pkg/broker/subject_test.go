@@ -0,0 +1,62 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsWildcard(t *testing.T) {
+	assert.True(t, IsWildcard("orders.*"))
+	assert.True(t, IsWildcard("prices.>"))
+	assert.True(t, IsWildcard("*.created"))
+	assert.False(t, IsWildcard("orders.created"))
+	assert.False(t, IsWildcard("orders*"))
+	assert.False(t, IsWildcard("client--device"))
+}
+
+func TestValidateWildcard(t *testing.T) {
+	assert.NoError(t, ValidateWildcard("orders.*"))
+	assert.NoError(t, ValidateWildcard("prices.>"))
+	assert.NoError(t, ValidateWildcard("*.*.created"))
+	assert.Error(t, ValidateWildcard("prices.>.inr"))
+	assert.Error(t, ValidateWildcard("orders..*"))
+	assert.Error(t, ValidateWildcard("orders.*."))
+}
+
+func TestMatchSubject(t *testing.T) {
+	tests := []struct {
+		pattern string
+		subject string
+		want    bool
+	}{
+		{pattern: "orders.*", subject: "orders.created", want: true},
+		{pattern: "orders.*", subject: "orders", want: false},
+		{pattern: "orders.*", subject: "orders.created.v1", want: false},
+		{pattern: "prices.>", subject: "prices.btc", want: true},
+		{pattern: "prices.>", subject: "prices.btc.inr", want: true},
+		{pattern: "prices.>", subject: "prices", want: false},
+		{pattern: "*.created", subject: "orders.created", want: true},
+		{pattern: "*.created", subject: "orders.updated", want: false},
+		{pattern: "orders.created", subject: "orders.created", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.subject, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchSubject(tt.pattern, tt.subject))
+		})
+	}
+}
+
+func TestToGlob(t *testing.T) {
+	assert.Equal(t, "orders.*", ToGlob("orders.*"))
+	assert.Equal(t, "prices.*", ToGlob("prices.>"))
+	assert.Equal(t, `a\?b.*`, ToGlob("a?b.*"))
+}
+
+func TestLiteralPrefix(t *testing.T) {
+	assert.Equal(t, "orders.", LiteralPrefix("orders.*"))
+	assert.Equal(t, "orders.eu.", LiteralPrefix("orders.eu.>"))
+	assert.Equal(t, "", LiteralPrefix(">"))
+	assert.Equal(t, "orders.created", LiteralPrefix("orders.created"))
+}
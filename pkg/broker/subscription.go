@@ -16,6 +16,10 @@ type BaseSubscription struct {
 type TopicEvent struct {
 	Event []byte
 	Topic string
+	// ID is the position of the event in a persistent broker, empty otherwise
+	ID string
+	// Subject is the subscribed subject or pattern the event was received for, empty if same as Topic
+	Subject string
 }
 
 // GetTopicEventChan returns topic with event channel
@@ -68,7 +68,7 @@ func (x Event_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Event_Type.Descriptor instead.
 func (Event_Type) EnumDescriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{19, 0}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{49, 0}
 }
 
 // ChannelRequest is the channel request holder
@@ -83,6 +83,7 @@ type ChannelRequest struct {
 	//	*ChannelRequest_ChannelEventAck
 	//	*ChannelRequest_TopicSubscriptionRequest
 	//	*ChannelRequest_TopicUnsubscriptionRequest
+	//	*ChannelRequest_ChannelFilterRequest
 	Request isChannelRequest_Request `protobuf_oneof:"request"`
 }
 
@@ -151,6 +152,13 @@ func (x *ChannelRequest) GetTopicUnsubscriptionRequest() *TopicUnsubscriptionReq
 	return nil
 }
 
+func (x *ChannelRequest) GetChannelFilterRequest() *ChannelFilterRequest {
+	if x, ok := x.GetRequest().(*ChannelRequest_ChannelFilterRequest); ok {
+		return x.ChannelFilterRequest
+	}
+	return nil
+}
+
 type isChannelRequest_Request interface {
 	isChannelRequest_Request()
 }
@@ -175,6 +183,11 @@ type ChannelRequest_TopicUnsubscriptionRequest struct {
 	TopicUnsubscriptionRequest *TopicUnsubscriptionRequest `protobuf:"bytes,4,opt,name=topic_unsubscription_request,json=topicUnsubscriptionRequest,proto3,oneof"`
 }
 
+type ChannelRequest_ChannelFilterRequest struct {
+	// channel_filter_request to filter the events delivered on the channel
+	ChannelFilterRequest *ChannelFilterRequest `protobuf:"bytes,5,opt,name=channel_filter_request,json=channelFilterRequest,proto3,oneof"`
+}
+
 func (*ChannelRequest_ChannelEvent) isChannelRequest_Request() {}
 
 func (*ChannelRequest_ChannelEventAck) isChannelRequest_Request() {}
@@ -183,6 +196,8 @@ func (*ChannelRequest_TopicSubscriptionRequest) isChannelRequest_Request() {}
 
 func (*ChannelRequest_TopicUnsubscriptionRequest) isChannelRequest_Request() {}
 
+func (*ChannelRequest_ChannelFilterRequest) isChannelRequest_Request() {}
+
 // ChannelResponse is the channel response holder
 type ChannelResponse struct {
 	state         protoimpl.MessageState
@@ -196,6 +211,7 @@ type ChannelResponse struct {
 	//	*ChannelResponse_ChannelEventAck
 	//	*ChannelResponse_TopicSubscriptionRequestAck
 	//	*ChannelResponse_TopicUnsubscriptionRequestAck
+	//	*ChannelResponse_ChannelFilterRequestAck
 	Response isChannelResponse_Response `protobuf_oneof:"response"`
 }
 
@@ -271,6 +287,13 @@ func (x *ChannelResponse) GetTopicUnsubscriptionRequestAck() *TopicUnsubscriptio
 	return nil
 }
 
+func (x *ChannelResponse) GetChannelFilterRequestAck() *ChannelFilterRequestAck {
+	if x, ok := x.GetResponse().(*ChannelResponse_ChannelFilterRequestAck); ok {
+		return x.ChannelFilterRequestAck
+	}
+	return nil
+}
+
 type isChannelResponse_Response interface {
 	isChannelResponse_Response()
 }
@@ -300,6 +323,11 @@ type ChannelResponse_TopicUnsubscriptionRequestAck struct {
 	TopicUnsubscriptionRequestAck *TopicUnsubscriptionRequestAck `protobuf:"bytes,5,opt,name=topic_unsubscription_request_ack,json=topicUnsubscriptionRequestAck,proto3,oneof"`
 }
 
+type ChannelResponse_ChannelFilterRequestAck struct {
+	// channel_filter_request_ack is ack of channel_filter_request
+	ChannelFilterRequestAck *ChannelFilterRequestAck `protobuf:"bytes,6,opt,name=channel_filter_request_ack,json=channelFilterRequestAck,proto3,oneof"`
+}
+
 func (*ChannelResponse_ConnectAck) isChannelResponse_Response() {}
 
 func (*ChannelResponse_ChannelEvent) isChannelResponse_Response() {}
@@ -310,6 +338,8 @@ func (*ChannelResponse_TopicSubscriptionRequestAck) isChannelResponse_Response()
 
 func (*ChannelResponse_TopicUnsubscriptionRequestAck) isChannelResponse_Response() {}
 
+func (*ChannelResponse_ChannelFilterRequestAck) isChannelResponse_Response() {}
+
 // ChannelEvent is the main payload
 type ChannelEvent struct {
 	state         protoimpl.MessageState
@@ -322,6 +352,9 @@ type ChannelEvent struct {
 	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
 	// event sent and received
 	Event *Event `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// cursor is the position of the event in a persistent broker, it is empty if persistence is disabled.
+	// Cursor of the last event received can be passed on reconnect to receive only the events missed since then.
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *ChannelEvent) Reset() {
@@ -375,6 +408,13 @@ func (x *ChannelEvent) GetEvent() *Event {
 	return nil
 }
 
+func (x *ChannelEvent) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 // ConnectResponse is the response client gets on connecting to channel
 type ConnectAck struct {
 	state         protoimpl.MessageState
@@ -383,6 +423,10 @@ type ConnectAck struct {
 
 	// Represents a generic Response which indicates success/failure status of every request
 	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// topics the channel is subscribed to on connect, as persisted from earlier channels
+	Topics []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	// topic_acks are the results of subscribing to the topics passed on connect
+	TopicAcks []*TopicSubscriptionRequestAck `protobuf:"bytes,3,rep,name=topic_acks,json=topicAcks,proto3" json:"topic_acks,omitempty"`
 }
 
 func (x *ConnectAck) Reset() {
@@ -422,6 +466,20 @@ func (x *ConnectAck) GetStatus() *ResponseStatus {
 	return nil
 }
 
+func (x *ConnectAck) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *ConnectAck) GetTopicAcks() []*TopicSubscriptionRequestAck {
+	if x != nil {
+		return x.TopicAcks
+	}
+	return nil
+}
+
 // ChannelEventAck is the ack of a ChannelEvent
 type ChannelEventAck struct {
 	state         protoimpl.MessageState
@@ -478,6 +536,80 @@ func (x *ChannelEventAck) GetStatus() *ResponseStatus {
 	return nil
 }
 
+// UpstreamEvent is a ChannelEvent received from a client, as routed to the backend
+type UpstreamEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client which sent the event
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// device of the client which sent the event, empty if device support is disabled
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// channel_event received from the client
+	ChannelEvent *ChannelEvent `protobuf:"bytes,3,opt,name=channel_event,json=channelEvent,proto3" json:"channel_event,omitempty"`
+	// time at which the event was received
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+}
+
+func (x *UpstreamEvent) Reset() {
+	*x = UpstreamEvent{}
+	mi := &file_push_v1_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpstreamEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamEvent) ProtoMessage() {}
+
+func (x *UpstreamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamEvent.ProtoReflect.Descriptor instead.
+func (*UpstreamEvent) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpstreamEvent) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *UpstreamEvent) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *UpstreamEvent) GetChannelEvent() *ChannelEvent {
+	if x != nil {
+		return x.ChannelEvent
+	}
+	return nil
+}
+
+func (x *UpstreamEvent) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
 // TopicSubscriptionRequest
 type TopicSubscriptionRequest struct {
 	state         protoimpl.MessageState
@@ -486,11 +618,13 @@ type TopicSubscriptionRequest struct {
 
 	// topic to subscribe
 	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// filter of the events delivered from the topic, all events are delivered if not set
+	Filter *EventFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *TopicSubscriptionRequest) Reset() {
 	*x = TopicSubscriptionRequest{}
-	mi := &file_push_v1_api_proto_msgTypes[5]
+	mi := &file_push_v1_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +636,7 @@ func (x *TopicSubscriptionRequest) String() string {
 func (*TopicSubscriptionRequest) ProtoMessage() {}
 
 func (x *TopicSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[5]
+	mi := &file_push_v1_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +649,7 @@ func (x *TopicSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*TopicSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{5}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TopicSubscriptionRequest) GetTopic() string {
@@ -525,6 +659,13 @@ func (x *TopicSubscriptionRequest) GetTopic() string {
 	return ""
 }
 
+func (x *TopicSubscriptionRequest) GetFilter() *EventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 // TopicSubscriptionRequest
 type TopicSubscriptionRequestAck struct {
 	state         protoimpl.MessageState
@@ -539,7 +680,7 @@ type TopicSubscriptionRequestAck struct {
 
 func (x *TopicSubscriptionRequestAck) Reset() {
 	*x = TopicSubscriptionRequestAck{}
-	mi := &file_push_v1_api_proto_msgTypes[6]
+	mi := &file_push_v1_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -551,7 +692,7 @@ func (x *TopicSubscriptionRequestAck) String() string {
 func (*TopicSubscriptionRequestAck) ProtoMessage() {}
 
 func (x *TopicSubscriptionRequestAck) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[6]
+	mi := &file_push_v1_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +705,7 @@ func (x *TopicSubscriptionRequestAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicSubscriptionRequestAck.ProtoReflect.Descriptor instead.
 func (*TopicSubscriptionRequestAck) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{6}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TopicSubscriptionRequestAck) GetTopic() string {
@@ -593,7 +734,7 @@ type TopicUnsubscriptionRequest struct {
 
 func (x *TopicUnsubscriptionRequest) Reset() {
 	*x = TopicUnsubscriptionRequest{}
-	mi := &file_push_v1_api_proto_msgTypes[7]
+	mi := &file_push_v1_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -605,7 +746,7 @@ func (x *TopicUnsubscriptionRequest) String() string {
 func (*TopicUnsubscriptionRequest) ProtoMessage() {}
 
 func (x *TopicUnsubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[7]
+	mi := &file_push_v1_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +759,7 @@ func (x *TopicUnsubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicUnsubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*TopicUnsubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{7}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TopicUnsubscriptionRequest) GetTopic() string {
@@ -642,7 +783,7 @@ type TopicUnsubscriptionRequestAck struct {
 
 func (x *TopicUnsubscriptionRequestAck) Reset() {
 	*x = TopicUnsubscriptionRequestAck{}
-	mi := &file_push_v1_api_proto_msgTypes[8]
+	mi := &file_push_v1_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +795,7 @@ func (x *TopicUnsubscriptionRequestAck) String() string {
 func (*TopicUnsubscriptionRequestAck) ProtoMessage() {}
 
 func (x *TopicUnsubscriptionRequestAck) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[8]
+	mi := &file_push_v1_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +808,7 @@ func (x *TopicUnsubscriptionRequestAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicUnsubscriptionRequestAck.ProtoReflect.Descriptor instead.
 func (*TopicUnsubscriptionRequestAck) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{8}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TopicUnsubscriptionRequestAck) GetTopic() string {
@@ -684,81 +825,33 @@ func (x *TopicUnsubscriptionRequestAck) GetStatus() *ResponseStatus {
 	return nil
 }
 
-// GetClientActiveDevicesRequest is the request to get active devices of a client
-type GetClientActiveDevicesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// client_id for which devices to fetch devices
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-}
-
-func (x *GetClientActiveDevicesRequest) Reset() {
-	*x = GetClientActiveDevicesRequest{}
-	mi := &file_push_v1_api_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetClientActiveDevicesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetClientActiveDevicesRequest) ProtoMessage() {}
-
-func (x *GetClientActiveDevicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetClientActiveDevicesRequest.ProtoReflect.Descriptor instead.
-func (*GetClientActiveDevicesRequest) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *GetClientActiveDevicesRequest) GetClientId() string {
-	if x != nil {
-		return x.ClientId
-	}
-	return ""
-}
-
-// GetClientActiveDevicesResponse is the response of GetClientActiveDevices API
-type GetClientActiveDevicesResponse struct {
+// EventFilter selects the events delivered on a channel by their name,
+// names are matched with shell patterns, e.g. `price.*`
+type EventFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// generic response which indicates success/failure status of every request
-	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// is_client_online is a boolean to indicate if the client is online
-	IsClientOnline bool `protobuf:"varint,2,opt,name=is_client_online,json=isClientOnline,proto3" json:"is_client_online,omitempty"`
-	// list of active devices of a client
-	Devices []*Device `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`
+	// include_names, if set, only events with a name matching one of them are delivered
+	IncludeNames []string `protobuf:"bytes,1,rep,name=include_names,json=includeNames,proto3" json:"include_names,omitempty"`
+	// exclude_names, events with a name matching one of them are not delivered
+	ExcludeNames []string `protobuf:"bytes,2,rep,name=exclude_names,json=excludeNames,proto3" json:"exclude_names,omitempty"`
 }
 
-func (x *GetClientActiveDevicesResponse) Reset() {
-	*x = GetClientActiveDevicesResponse{}
+func (x *EventFilter) Reset() {
+	*x = EventFilter{}
 	mi := &file_push_v1_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClientActiveDevicesResponse) String() string {
+func (x *EventFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClientActiveDevicesResponse) ProtoMessage() {}
+func (*EventFilter) ProtoMessage() {}
 
-func (x *GetClientActiveDevicesResponse) ProtoReflect() protoreflect.Message {
+func (x *EventFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_push_v1_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -770,58 +863,49 @@ func (x *GetClientActiveDevicesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClientActiveDevicesResponse.ProtoReflect.Descriptor instead.
-func (*GetClientActiveDevicesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
+func (*EventFilter) Descriptor() ([]byte, []int) {
 	return file_push_v1_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetClientActiveDevicesResponse) GetStatus() *ResponseStatus {
+func (x *EventFilter) GetIncludeNames() []string {
 	if x != nil {
-		return x.Status
+		return x.IncludeNames
 	}
 	return nil
 }
 
-func (x *GetClientActiveDevicesResponse) GetIsClientOnline() bool {
-	if x != nil {
-		return x.IsClientOnline
-	}
-	return false
-}
-
-func (x *GetClientActiveDevicesResponse) GetDevices() []*Device {
+func (x *EventFilter) GetExcludeNames() []string {
 	if x != nil {
-		return x.Devices
+		return x.ExcludeNames
 	}
 	return nil
 }
 
-// SendEventToClientChannelRequest is the request to send event to a client
-type SendEventToClientChannelRequest struct {
+// ChannelFilterRequest
+type ChannelFilterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// client_id is client id to which the event is to be sent
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// event sent or received
-	Event *Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// filter of the events delivered on the channel, the filter is cleared if not set
+	Filter *EventFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *SendEventToClientChannelRequest) Reset() {
-	*x = SendEventToClientChannelRequest{}
+func (x *ChannelFilterRequest) Reset() {
+	*x = ChannelFilterRequest{}
 	mi := &file_push_v1_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendEventToClientChannelRequest) String() string {
+func (x *ChannelFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendEventToClientChannelRequest) ProtoMessage() {}
+func (*ChannelFilterRequest) ProtoMessage() {}
 
-func (x *SendEventToClientChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *ChannelFilterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_push_v1_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -833,27 +917,20 @@ func (x *SendEventToClientChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendEventToClientChannelRequest.ProtoReflect.Descriptor instead.
-func (*SendEventToClientChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChannelFilterRequest.ProtoReflect.Descriptor instead.
+func (*ChannelFilterRequest) Descriptor() ([]byte, []int) {
 	return file_push_v1_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SendEventToClientChannelRequest) GetClientId() string {
-	if x != nil {
-		return x.ClientId
-	}
-	return ""
-}
-
-func (x *SendEventToClientChannelRequest) GetEvent() *Event {
+func (x *ChannelFilterRequest) GetFilter() *EventFilter {
 	if x != nil {
-		return x.Event
+		return x.Filter
 	}
 	return nil
 }
 
-// SendEventToClientChannelResponse is the response of SendEventToClientChannel API
-type SendEventToClientChannelResponse struct {
+// ChannelFilterRequestAck
+type ChannelFilterRequestAck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -862,20 +939,20 @@ type SendEventToClientChannelResponse struct {
 	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *SendEventToClientChannelResponse) Reset() {
-	*x = SendEventToClientChannelResponse{}
+func (x *ChannelFilterRequestAck) Reset() {
+	*x = ChannelFilterRequestAck{}
 	mi := &file_push_v1_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendEventToClientChannelResponse) String() string {
+func (x *ChannelFilterRequestAck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendEventToClientChannelResponse) ProtoMessage() {}
+func (*ChannelFilterRequestAck) ProtoMessage() {}
 
-func (x *SendEventToClientChannelResponse) ProtoReflect() protoreflect.Message {
+func (x *ChannelFilterRequestAck) ProtoReflect() protoreflect.Message {
 	mi := &file_push_v1_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -887,46 +964,42 @@ func (x *SendEventToClientChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendEventToClientChannelResponse.ProtoReflect.Descriptor instead.
-func (*SendEventToClientChannelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChannelFilterRequestAck.ProtoReflect.Descriptor instead.
+func (*ChannelFilterRequestAck) Descriptor() ([]byte, []int) {
 	return file_push_v1_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SendEventToClientChannelResponse) GetStatus() *ResponseStatus {
+func (x *ChannelFilterRequestAck) GetStatus() *ResponseStatus {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-// SendEventToClientDeviceChannelRequest is the request to send event to a client with a device
-type SendEventToClientDeviceChannelRequest struct {
+// GetClientActiveDevicesRequest is the request to get active devices of a client
+type GetClientActiveDevicesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// client_id is client id to which the event is to be sent
+	// client_id for which devices to fetch devices
 	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// device_id is the device id to which the event is to be sent
-	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	// event sent or received
-	Event *Event `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *SendEventToClientDeviceChannelRequest) Reset() {
-	*x = SendEventToClientDeviceChannelRequest{}
+func (x *GetClientActiveDevicesRequest) Reset() {
+	*x = GetClientActiveDevicesRequest{}
 	mi := &file_push_v1_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendEventToClientDeviceChannelRequest) String() string {
+func (x *GetClientActiveDevicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendEventToClientDeviceChannelRequest) ProtoMessage() {}
+func (*GetClientActiveDevicesRequest) ProtoMessage() {}
 
-func (x *SendEventToClientDeviceChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *GetClientActiveDevicesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_push_v1_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -938,57 +1011,1717 @@ func (x *SendEventToClientDeviceChannelRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendEventToClientDeviceChannelRequest.ProtoReflect.Descriptor instead.
-func (*SendEventToClientDeviceChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClientActiveDevicesRequest.ProtoReflect.Descriptor instead.
+func (*GetClientActiveDevicesRequest) Descriptor() ([]byte, []int) {
 	return file_push_v1_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SendEventToClientDeviceChannelRequest) GetClientId() string {
+func (x *GetClientActiveDevicesRequest) GetClientId() string {
 	if x != nil {
 		return x.ClientId
 	}
 	return ""
 }
 
-func (x *SendEventToClientDeviceChannelRequest) GetDeviceId() string {
-	if x != nil {
-		return x.DeviceId
-	}
-	return ""
-}
+// GetClientActiveDevicesResponse is the response of GetClientActiveDevices API
+type GetClientActiveDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *SendEventToClientDeviceChannelRequest) GetEvent() *Event {
-	if x != nil {
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// is_client_online is a boolean to indicate if the client is online
+	IsClientOnline bool `protobuf:"varint,2,opt,name=is_client_online,json=isClientOnline,proto3" json:"is_client_online,omitempty"`
+	// list of active devices of a client
+	Devices []*Device `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *GetClientActiveDevicesResponse) Reset() {
+	*x = GetClientActiveDevicesResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientActiveDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientActiveDevicesResponse) ProtoMessage() {}
+
+func (x *GetClientActiveDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientActiveDevicesResponse.ProtoReflect.Descriptor instead.
+func (*GetClientActiveDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetClientActiveDevicesResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetClientActiveDevicesResponse) GetIsClientOnline() bool {
+	if x != nil {
+		return x.IsClientOnline
+	}
+	return false
+}
+
+func (x *GetClientActiveDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+// SubscribeClientToTopicRequest is the request to subscribe the channels of a client to a topic
+type SubscribeClientToTopicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_id of the client to subscribe
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// (optional) device_id to subscribe only the channels of the device
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// topic to subscribe to
+	Topic string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *SubscribeClientToTopicRequest) Reset() {
+	*x = SubscribeClientToTopicRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeClientToTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeClientToTopicRequest) ProtoMessage() {}
+
+func (x *SubscribeClientToTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeClientToTopicRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeClientToTopicRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubscribeClientToTopicRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SubscribeClientToTopicRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SubscribeClientToTopicRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+// SubscribeClientToTopicResponse is the response of SubscribeClientToTopic API
+type SubscribeClientToTopicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SubscribeClientToTopicResponse) Reset() {
+	*x = SubscribeClientToTopicResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeClientToTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeClientToTopicResponse) ProtoMessage() {}
+
+func (x *SubscribeClientToTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeClientToTopicResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeClientToTopicResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SubscribeClientToTopicResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// UnsubscribeClientFromTopicRequest is the request to unsubscribe the channels of a client from a topic
+type UnsubscribeClientFromTopicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_id of the client to unsubscribe
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// (optional) device_id to unsubscribe only the channels of the device
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// topic to unsubscribe from
+	Topic string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *UnsubscribeClientFromTopicRequest) Reset() {
+	*x = UnsubscribeClientFromTopicRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeClientFromTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeClientFromTopicRequest) ProtoMessage() {}
+
+func (x *UnsubscribeClientFromTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeClientFromTopicRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeClientFromTopicRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnsubscribeClientFromTopicRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *UnsubscribeClientFromTopicRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *UnsubscribeClientFromTopicRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+// UnsubscribeClientFromTopicResponse is the response of UnsubscribeClientFromTopic API
+type UnsubscribeClientFromTopicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UnsubscribeClientFromTopicResponse) Reset() {
+	*x = UnsubscribeClientFromTopicResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeClientFromTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeClientFromTopicResponse) ProtoMessage() {}
+
+func (x *UnsubscribeClientFromTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeClientFromTopicResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeClientFromTopicResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnsubscribeClientFromTopicResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// DisconnectClientRequest is the request to close the channels of a client
+type DisconnectClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_id of the client to disconnect
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// (optional) device_id to disconnect only the channels of the device
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// (optional) reason of the disconnect, sent to the client in the status of the closed channel
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DisconnectClientRequest) Reset() {
+	*x = DisconnectClientRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectClientRequest) ProtoMessage() {}
+
+func (x *DisconnectClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectClientRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectClientRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DisconnectClientRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *DisconnectClientRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DisconnectClientRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DisconnectClientResponse is the response of DisconnectClient API
+type DisconnectClientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DisconnectClientResponse) Reset() {
+	*x = DisconnectClientResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectClientResponse) ProtoMessage() {}
+
+func (x *DisconnectClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectClientResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectClientResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DisconnectClientResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// ControlEvent is sent by propeller to the channels of a client to act on them, it is not delivered to the client
+type ControlEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// issued_at is the time the control event was sent
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// (optional) device_id to act only on the channels of the device
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// Types that are assignable to Control:
+	//
+	//	*ControlEvent_Disconnect
+	//	*ControlEvent_Subscribe
+	//	*ControlEvent_Unsubscribe
+	Control isControlEvent_Control `protobuf_oneof:"control"`
+}
+
+func (x *ControlEvent) Reset() {
+	*x = ControlEvent{}
+	mi := &file_push_v1_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlEvent) ProtoMessage() {}
+
+func (x *ControlEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlEvent.ProtoReflect.Descriptor instead.
+func (*ControlEvent) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ControlEvent) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *ControlEvent) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (m *ControlEvent) GetControl() isControlEvent_Control {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+func (x *ControlEvent) GetDisconnect() *DisconnectControl {
+	if x, ok := x.GetControl().(*ControlEvent_Disconnect); ok {
+		return x.Disconnect
+	}
+	return nil
+}
+
+func (x *ControlEvent) GetSubscribe() *TopicControl {
+	if x, ok := x.GetControl().(*ControlEvent_Subscribe); ok {
+		return x.Subscribe
+	}
+	return nil
+}
+
+func (x *ControlEvent) GetUnsubscribe() *TopicControl {
+	if x, ok := x.GetControl().(*ControlEvent_Unsubscribe); ok {
+		return x.Unsubscribe
+	}
+	return nil
+}
+
+type isControlEvent_Control interface {
+	isControlEvent_Control()
+}
+
+type ControlEvent_Disconnect struct {
+	// disconnect closes the channel
+	Disconnect *DisconnectControl `protobuf:"bytes,3,opt,name=disconnect,proto3,oneof"`
+}
+
+type ControlEvent_Subscribe struct {
+	// subscribe subscribes the channel to a topic
+	Subscribe *TopicControl `protobuf:"bytes,4,opt,name=subscribe,proto3,oneof"`
+}
+
+type ControlEvent_Unsubscribe struct {
+	// unsubscribe unsubscribes the channel from a topic
+	Unsubscribe *TopicControl `protobuf:"bytes,5,opt,name=unsubscribe,proto3,oneof"`
+}
+
+func (*ControlEvent_Disconnect) isControlEvent_Control() {}
+
+func (*ControlEvent_Subscribe) isControlEvent_Control() {}
+
+func (*ControlEvent_Unsubscribe) isControlEvent_Control() {}
+
+// TopicControl subscribes or unsubscribes the channel to a topic
+type TopicControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// topic to subscribe or unsubscribe
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *TopicControl) Reset() {
+	*x = TopicControl{}
+	mi := &file_push_v1_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicControl) ProtoMessage() {}
+
+func (x *TopicControl) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicControl.ProtoReflect.Descriptor instead.
+func (*TopicControl) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TopicControl) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+// DisconnectControl closes the channel
+type DisconnectControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reason of the disconnect
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// (optional) channel_ids to close only the channels with the ids, e.g. on eviction
+	ChannelIds []string `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	// (optional) event sent to the client before the channel is closed
+	Event *Event `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *DisconnectControl) Reset() {
+	*x = DisconnectControl{}
+	mi := &file_push_v1_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectControl) ProtoMessage() {}
+
+func (x *DisconnectControl) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectControl.ProtoReflect.Descriptor instead.
+func (*DisconnectControl) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DisconnectControl) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DisconnectControl) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *DisconnectControl) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// GetClientsOnlineStatusRequest is the request to get the online status of multiple clients
+type GetClientsOnlineStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_ids for which to fetch the online status, up to 5000
+	ClientIds []string `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+}
+
+func (x *GetClientsOnlineStatusRequest) Reset() {
+	*x = GetClientsOnlineStatusRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientsOnlineStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientsOnlineStatusRequest) ProtoMessage() {}
+
+func (x *GetClientsOnlineStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientsOnlineStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetClientsOnlineStatusRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetClientsOnlineStatusRequest) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+// GetClientsOnlineStatusResponse is the response of GetClientsOnlineStatus API
+type GetClientsOnlineStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// statuses in the order of client_ids of the request
+	Statuses []*ClientOnlineStatus `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *GetClientsOnlineStatusResponse) Reset() {
+	*x = GetClientsOnlineStatusResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientsOnlineStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientsOnlineStatusResponse) ProtoMessage() {}
+
+func (x *GetClientsOnlineStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientsOnlineStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetClientsOnlineStatusResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetClientsOnlineStatusResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetClientsOnlineStatusResponse) GetStatuses() []*ClientOnlineStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// ClientOnlineStatus is the online status of a client
+type ClientOnlineStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_id of the client
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// is_online is true if the client has a live channel
+	IsOnline bool `protobuf:"varint,2,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	// device_count is the number of active devices of the client, if device support is enabled
+	DeviceCount int32 `protobuf:"varint,3,opt,name=device_count,json=deviceCount,proto3" json:"device_count,omitempty"`
+}
+
+func (x *ClientOnlineStatus) Reset() {
+	*x = ClientOnlineStatus{}
+	mi := &file_push_v1_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientOnlineStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientOnlineStatus) ProtoMessage() {}
+
+func (x *ClientOnlineStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientOnlineStatus.ProtoReflect.Descriptor instead.
+func (*ClientOnlineStatus) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ClientOnlineStatus) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientOnlineStatus) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+func (x *ClientOnlineStatus) GetDeviceCount() int32 {
+	if x != nil {
+		return x.DeviceCount
+	}
+	return 0
+}
+
+// GetTopicPresenceRequest is the request to get the clients subscribed to a topic
+type GetTopicPresenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// topic for which to fetch the subscribed clients
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *GetTopicPresenceRequest) Reset() {
+	*x = GetTopicPresenceRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicPresenceRequest) ProtoMessage() {}
+
+func (x *GetTopicPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicPresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetTopicPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTopicPresenceRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+// GetTopicPresenceResponse is the response of GetTopicPresence API
+type GetTopicPresenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// list of clients subscribed to the topic
+	Members []*TopicPresenceMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GetTopicPresenceResponse) Reset() {
+	*x = GetTopicPresenceResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopicPresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicPresenceResponse) ProtoMessage() {}
+
+func (x *GetTopicPresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicPresenceResponse.ProtoReflect.Descriptor instead.
+func (*GetTopicPresenceResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTopicPresenceResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetTopicPresenceResponse) GetMembers() []*TopicPresenceMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// ListNodesRequest is the request to get the live nodes of the cluster
+type ListNodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListNodesRequest) Reset() {
+	*x = ListNodesRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesRequest) ProtoMessage() {}
+
+func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
+func (*ListNodesRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{29}
+}
+
+// ListNodesResponse is the response of ListNodes API
+type ListNodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// list of live nodes
+	Nodes []*Node `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *ListNodesResponse) Reset() {
+	*x = ListNodesResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesResponse) ProtoMessage() {}
+
+func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodesResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListNodesResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListNodesResponse) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// Node is a propeller instance of the cluster
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unique node id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address at which the node is reachable
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// time at which the node started
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// last heartbeat of the node
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// number of channels connected to the node at the last heartbeat
+	Connections int64 `protobuf:"varint,5,opt,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_push_v1_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Node) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Node) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Node) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Node) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Node) GetConnections() int64 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+// TopicPresenceMember is a client, and its device if any, subscribed to a topic.
+// It is also the data of PRESENCE_JOIN and PRESENCE_LEAVE events.
+type TopicPresenceMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_id of the subscribed client
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// device_id of the subscribed device
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+}
+
+func (x *TopicPresenceMember) Reset() {
+	*x = TopicPresenceMember{}
+	mi := &file_push_v1_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicPresenceMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicPresenceMember) ProtoMessage() {}
+
+func (x *TopicPresenceMember) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicPresenceMember.ProtoReflect.Descriptor instead.
+func (*TopicPresenceMember) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TopicPresenceMember) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TopicPresenceMember) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+// SendEventToClientChannelRequest is the request to send event to a client
+type SendEventToClientChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_id is client id to which the event is to be sent
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// event sent or received
+	Event *Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *SendEventToClientChannelRequest) Reset() {
+	*x = SendEventToClientChannelRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventToClientChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventToClientChannelRequest) ProtoMessage() {}
+
+func (x *SendEventToClientChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventToClientChannelRequest.ProtoReflect.Descriptor instead.
+func (*SendEventToClientChannelRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SendEventToClientChannelRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SendEventToClientChannelRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// SendEventToClientChannelResponse is the response of SendEventToClientChannel API
+type SendEventToClientChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// duplicate is true if the event was already sent with the same idempotency_key, and was not sent again
+	Duplicate bool `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+}
+
+func (x *SendEventToClientChannelResponse) Reset() {
+	*x = SendEventToClientChannelResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventToClientChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventToClientChannelResponse) ProtoMessage() {}
+
+func (x *SendEventToClientChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventToClientChannelResponse.ProtoReflect.Descriptor instead.
+func (*SendEventToClientChannelResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SendEventToClientChannelResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SendEventToClientChannelResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// SendEventToClientDeviceChannelRequest is the request to send event to a client with a device
+type SendEventToClientDeviceChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_id is client id to which the event is to be sent
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// device_id is the device id to which the event is to be sent
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// event sent or received
+	Event *Event `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *SendEventToClientDeviceChannelRequest) Reset() {
+	*x = SendEventToClientDeviceChannelRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventToClientDeviceChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventToClientDeviceChannelRequest) ProtoMessage() {}
+
+func (x *SendEventToClientDeviceChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventToClientDeviceChannelRequest.ProtoReflect.Descriptor instead.
+func (*SendEventToClientDeviceChannelRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SendEventToClientDeviceChannelRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SendEventToClientDeviceChannelRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SendEventToClientDeviceChannelRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// SendEventToClientDeviceChannelResponse is the response of SendEventToClientDeviceChannel API
+type SendEventToClientDeviceChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// duplicate is true if the event was already sent with the same idempotency_key, and was not sent again
+	Duplicate bool `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+}
+
+func (x *SendEventToClientDeviceChannelResponse) Reset() {
+	*x = SendEventToClientDeviceChannelResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventToClientDeviceChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventToClientDeviceChannelResponse) ProtoMessage() {}
+
+func (x *SendEventToClientDeviceChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventToClientDeviceChannelResponse.ProtoReflect.Descriptor instead.
+func (*SendEventToClientDeviceChannelResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SendEventToClientDeviceChannelResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SendEventToClientDeviceChannelResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// SendEventToClientsRequest is the request to send an event to multiple clients
+type SendEventToClientsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recipients of the event
+	Recipients []*Recipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// event sent or received
+	Event *Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *SendEventToClientsRequest) Reset() {
+	*x = SendEventToClientsRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventToClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventToClientsRequest) ProtoMessage() {}
+
+func (x *SendEventToClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventToClientsRequest.ProtoReflect.Descriptor instead.
+func (*SendEventToClientsRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SendEventToClientsRequest) GetRecipients() []*Recipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *SendEventToClientsRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// Recipient is a client, optionally scoped to one of its devices
+type Recipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client_id is client id to which the event is to be sent
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// device_id is the device id to which the event is to be sent, event is sent to the client if empty
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+}
+
+func (x *Recipient) Reset() {
+	*x = Recipient{}
+	mi := &file_push_v1_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipient) ProtoMessage() {}
+
+func (x *Recipient) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipient.ProtoReflect.Descriptor instead.
+func (*Recipient) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Recipient) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Recipient) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+// RecipientStatus is the status of sending an event to a recipient
+type RecipientStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recipient of the event
+	Recipient *Recipient `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// generic response which indicates success/failure status for the recipient
+	Status *ResponseStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// duplicate is true if the event was already sent to the recipient with the same idempotency_key
+	Duplicate bool `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+}
+
+func (x *RecipientStatus) Reset() {
+	*x = RecipientStatus{}
+	mi := &file_push_v1_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipientStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipientStatus) ProtoMessage() {}
+
+func (x *RecipientStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipientStatus.ProtoReflect.Descriptor instead.
+func (*RecipientStatus) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RecipientStatus) GetRecipient() *Recipient {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *RecipientStatus) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *RecipientStatus) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// SendEventToClientsResponse is the response of SendEventToClients API
+type SendEventToClientsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request, it fails if any recipient fails
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// status for each recipient in the order of the request
+	RecipientStatuses []*RecipientStatus `protobuf:"bytes,2,rep,name=recipient_statuses,json=recipientStatuses,proto3" json:"recipient_statuses,omitempty"`
+}
+
+func (x *SendEventToClientsResponse) Reset() {
+	*x = SendEventToClientsResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEventToClientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEventToClientsResponse) ProtoMessage() {}
+
+func (x *SendEventToClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEventToClientsResponse.ProtoReflect.Descriptor instead.
+func (*SendEventToClientsResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SendEventToClientsResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SendEventToClientsResponse) GetRecipientStatuses() []*RecipientStatus {
+	if x != nil {
+		return x.RecipientStatuses
+	}
+	return nil
+}
+
+// SendRequestToClientRequest is the request to send an event to a client and wait for its reply
+type SendRequestToClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client to which the event is to be sent
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// (optional) device of the client to which the event is to be sent
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// event sent, correlation_id is set by propeller
+	Event *Event `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// (optional) time to wait for the reply, defaults to 5 seconds
+	TimeoutInMs int32 `protobuf:"varint,4,opt,name=timeout_in_ms,json=timeoutInMs,proto3" json:"timeout_in_ms,omitempty"`
+}
+
+func (x *SendRequestToClientRequest) Reset() {
+	*x = SendRequestToClientRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendRequestToClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRequestToClientRequest) ProtoMessage() {}
+
+func (x *SendRequestToClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRequestToClientRequest.ProtoReflect.Descriptor instead.
+func (*SendRequestToClientRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SendRequestToClientRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SendRequestToClientRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *SendRequestToClientRequest) GetEvent() *Event {
+	if x != nil {
 		return x.Event
 	}
 	return nil
 }
 
-// SendEventToClientDeviceChannelResponse is the response of SendEventToClientDeviceChannel API
-type SendEventToClientDeviceChannelResponse struct {
+func (x *SendRequestToClientRequest) GetTimeoutInMs() int32 {
+	if x != nil {
+		return x.TimeoutInMs
+	}
+	return 0
+}
+
+// SendRequestToClientResponse is the response of SendRequestToClient API
+type SendRequestToClientResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// generic response which indicates success/failure status of every request
 	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// reply event sent by the client
+	Reply *Event `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *SendEventToClientDeviceChannelResponse) Reset() {
-	*x = SendEventToClientDeviceChannelResponse{}
-	mi := &file_push_v1_api_proto_msgTypes[14]
+func (x *SendRequestToClientResponse) Reset() {
+	*x = SendRequestToClientResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendEventToClientDeviceChannelResponse) String() string {
+func (x *SendRequestToClientResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendEventToClientDeviceChannelResponse) ProtoMessage() {}
+func (*SendRequestToClientResponse) ProtoMessage() {}
 
-func (x *SendEventToClientDeviceChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[14]
+func (x *SendRequestToClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,18 +2732,25 @@ func (x *SendEventToClientDeviceChannelResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendEventToClientDeviceChannelResponse.ProtoReflect.Descriptor instead.
-func (*SendEventToClientDeviceChannelResponse) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SendRequestToClientResponse.ProtoReflect.Descriptor instead.
+func (*SendRequestToClientResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SendEventToClientDeviceChannelResponse) GetStatus() *ResponseStatus {
+func (x *SendRequestToClientResponse) GetStatus() *ResponseStatus {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
+func (x *SendRequestToClientResponse) GetReply() *Event {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
 // SendEventToTopicRequest is the request to send event to a topic
 type SendEventToTopicRequest struct {
 	state         protoimpl.MessageState
@@ -1025,7 +2765,7 @@ type SendEventToTopicRequest struct {
 
 func (x *SendEventToTopicRequest) Reset() {
 	*x = SendEventToTopicRequest{}
-	mi := &file_push_v1_api_proto_msgTypes[15]
+	mi := &file_push_v1_api_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1037,7 +2777,7 @@ func (x *SendEventToTopicRequest) String() string {
 func (*SendEventToTopicRequest) ProtoMessage() {}
 
 func (x *SendEventToTopicRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[15]
+	mi := &file_push_v1_api_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,7 +2790,7 @@ func (x *SendEventToTopicRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendEventToTopicRequest.ProtoReflect.Descriptor instead.
 func (*SendEventToTopicRequest) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{15}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SendEventToTopicRequest) GetTopic() string {
@@ -1075,11 +2815,13 @@ type SendEventToTopicResponse struct {
 
 	// generic response which indicates success/failure status of every request
 	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// duplicate is true if the event was already sent with the same idempotency_key, and was not sent again
+	Duplicate bool `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
 }
 
 func (x *SendEventToTopicResponse) Reset() {
 	*x = SendEventToTopicResponse{}
-	mi := &file_push_v1_api_proto_msgTypes[16]
+	mi := &file_push_v1_api_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1091,7 +2833,7 @@ func (x *SendEventToTopicResponse) String() string {
 func (*SendEventToTopicResponse) ProtoMessage() {}
 
 func (x *SendEventToTopicResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[16]
+	mi := &file_push_v1_api_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,7 +2846,7 @@ func (x *SendEventToTopicResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendEventToTopicResponse.ProtoReflect.Descriptor instead.
 func (*SendEventToTopicResponse) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{16}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SendEventToTopicResponse) GetStatus() *ResponseStatus {
@@ -1114,6 +2856,13 @@ func (x *SendEventToTopicResponse) GetStatus() *ResponseStatus {
 	return nil
 }
 
+func (x *SendEventToTopicResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
 // SendEventToTopicsRequest is the request to send event to multiple topics
 type SendEventToTopicsRequest struct {
 	state         protoimpl.MessageState
@@ -1126,7 +2875,7 @@ type SendEventToTopicsRequest struct {
 
 func (x *SendEventToTopicsRequest) Reset() {
 	*x = SendEventToTopicsRequest{}
-	mi := &file_push_v1_api_proto_msgTypes[17]
+	mi := &file_push_v1_api_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1138,7 +2887,7 @@ func (x *SendEventToTopicsRequest) String() string {
 func (*SendEventToTopicsRequest) ProtoMessage() {}
 
 func (x *SendEventToTopicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[17]
+	mi := &file_push_v1_api_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,7 +2900,7 @@ func (x *SendEventToTopicsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendEventToTopicsRequest.ProtoReflect.Descriptor instead.
 func (*SendEventToTopicsRequest) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{17}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SendEventToTopicsRequest) GetRequests() []*SendEventToTopicRequest {
@@ -1173,7 +2922,7 @@ type SendEventToTopicsResponse struct {
 
 func (x *SendEventToTopicsResponse) Reset() {
 	*x = SendEventToTopicsResponse{}
-	mi := &file_push_v1_api_proto_msgTypes[18]
+	mi := &file_push_v1_api_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1185,7 +2934,7 @@ func (x *SendEventToTopicsResponse) String() string {
 func (*SendEventToTopicsResponse) ProtoMessage() {}
 
 func (x *SendEventToTopicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[18]
+	mi := &file_push_v1_api_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +2947,7 @@ func (x *SendEventToTopicsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendEventToTopicsResponse.ProtoReflect.Descriptor instead.
 func (*SendEventToTopicsResponse) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{18}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *SendEventToTopicsResponse) GetStatus() *ResponseStatus {
@@ -1208,6 +2957,118 @@ func (x *SendEventToTopicsResponse) GetStatus() *ResponseStatus {
 	return nil
 }
 
+// BroadcastEventRequest is the request to send an event to every connected client
+type BroadcastEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// event sent or received
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// (optional) if set, the event is only sent to devices having all these attributes
+	AttributeFilter map[string]string `protobuf:"bytes,2,rep,name=attribute_filter,json=attributeFilter,proto3" json:"attribute_filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BroadcastEventRequest) Reset() {
+	*x = BroadcastEventRequest{}
+	mi := &file_push_v1_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastEventRequest) ProtoMessage() {}
+
+func (x *BroadcastEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastEventRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastEventRequest) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BroadcastEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *BroadcastEventRequest) GetAttributeFilter() map[string]string {
+	if x != nil {
+		return x.AttributeFilter
+	}
+	return nil
+}
+
+// BroadcastEventResponse is the response of BroadcastEvent API
+type BroadcastEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// generic response which indicates success/failure status of every request
+	Status *ResponseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// duplicate is true if the event was already sent with the same idempotency_key, and was not sent again
+	Duplicate bool `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+}
+
+func (x *BroadcastEventResponse) Reset() {
+	*x = BroadcastEventResponse{}
+	mi := &file_push_v1_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastEventResponse) ProtoMessage() {}
+
+func (x *BroadcastEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_push_v1_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastEventResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastEventResponse) Descriptor() ([]byte, []int) {
+	return file_push_v1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BroadcastEventResponse) GetStatus() *ResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *BroadcastEventResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
 // Event holds the event structure
 type Event struct {
 	state         protoimpl.MessageState
@@ -1220,11 +3081,18 @@ type Event struct {
 	FormatType Event_Type `protobuf:"varint,2,opt,name=format_type,json=formatType,proto3,enum=push.v1.Event_Type" json:"format_type,omitempty"`
 	// data which the event carries
 	Data *anypb.Any `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// correlation_id is set on an event which expects a reply, the reply event carries the same correlation_id
+	CorrelationId string `protobuf:"bytes,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// (optional) expires_at is the time after which the event is dropped instead of being delivered
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// (optional) idempotency_key deduplicates retries, an event with the same key sent to the same
+	// client, device, or topic within the idempotency window is not delivered again
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_push_v1_api_proto_msgTypes[19]
+	mi := &file_push_v1_api_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1236,7 +3104,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[19]
+	mi := &file_push_v1_api_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +3117,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{19}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *Event) GetName() string {
@@ -1273,6 +3141,27 @@ func (x *Event) GetData() *anypb.Any {
 	return nil
 }
 
+func (x *Event) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *Event) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Event) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // Represents a generic Response which indicates success/failure status of every request
 type ResponseStatus struct {
 	state         protoimpl.MessageState
@@ -1291,7 +3180,7 @@ type ResponseStatus struct {
 
 func (x *ResponseStatus) Reset() {
 	*x = ResponseStatus{}
-	mi := &file_push_v1_api_proto_msgTypes[20]
+	mi := &file_push_v1_api_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1303,7 +3192,7 @@ func (x *ResponseStatus) String() string {
 func (*ResponseStatus) ProtoMessage() {}
 
 func (x *ResponseStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[20]
+	mi := &file_push_v1_api_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1316,7 +3205,7 @@ func (x *ResponseStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResponseStatus.ProtoReflect.Descriptor instead.
 func (*ResponseStatus) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{20}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ResponseStatus) GetSuccess() bool {
@@ -1359,11 +3248,13 @@ type Device struct {
 	LoggedInAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=logged_in_at,json=loggedInAt,proto3" json:"logged_in_at,omitempty"`
 	// device attributes
 	Attributes map[string]string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// last time the channel of the device was seen alive
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
 }
 
 func (x *Device) Reset() {
 	*x = Device{}
-	mi := &file_push_v1_api_proto_msgTypes[21]
+	mi := &file_push_v1_api_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1375,7 +3266,7 @@ func (x *Device) String() string {
 func (*Device) ProtoMessage() {}
 
 func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_push_v1_api_proto_msgTypes[21]
+	mi := &file_push_v1_api_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1388,7 +3279,7 @@ func (x *Device) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Device.ProtoReflect.Descriptor instead.
 func (*Device) Descriptor() ([]byte, []int) {
-	return file_push_v1_api_proto_rawDescGZIP(), []int{21}
+	return file_push_v1_api_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Device) GetId() string {
@@ -1412,6 +3303,13 @@ func (x *Device) GetAttributes() map[string]string {
 	return nil
 }
 
+func (x *Device) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
 var File_push_v1_api_proto protoreflect.FileDescriptor
 
 var file_push_v1_api_proto_rawDesc = []byte{
@@ -1420,7 +3318,7 @@ var file_push_v1_api_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61,
-	0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x03, 0x0a, 0x0e, 0x43, 0x68, 0x61,
 	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x63,
 	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
@@ -1442,220 +3340,533 @@ var file_push_v1_api_proto_rawDesc = []byte{
 	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
 	0x00, 0x52, 0x1a, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a,
-	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbb, 0x03, 0x0a, 0x0f, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x41, 0x63, 0x6b, 0x12, 0x3c, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x75,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x1e, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x1b, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x71, 0x0a, 0x20, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x5f, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x1d, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0x3d, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x2f, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5f,
-	0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63,
-	0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x2f,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x30, 0x0a, 0x18, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x22, 0x64, 0x0a, 0x1b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a,
+	0x16, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x9c, 0x04, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x61,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x3c, 0x0a, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x48, 0x00,
+	0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63,
+	0x6b, 0x12, 0x6b, 0x0a, 0x1e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x75, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x48,
+	0x00, 0x52, 0x1b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x71,
+	0x0a, 0x20, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61,
+	0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x32, 0x0a, 0x1a, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x66, 0x0a, 0x1d, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x22, 0xa6, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0e, 0x69, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x29, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x1f, 0x53, 0x65,
-	0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x22, 0x53, 0x0a, 0x20, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x25, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x48, 0x00, 0x52, 0x1d, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63,
+	0x6b, 0x12, 0x5f, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x63, 0x6b, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7f,
+	0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x9a, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x2f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63,
+	0x6b, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x63, 0x6b, 0x73, 0x22, 0x5f, 0x0a, 0x0f,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc2, 0x01,
+	0x0a, 0x0d, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
 	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
 	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0x59, 0x0a, 0x26, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x5e, 0x0a, 0x18, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x64, 0x0a, 0x1b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x32, 0x0a, 0x1a, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x66, 0x0a, 0x1d,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x44, 0x0a,
+	0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x2f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x3c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa6, 0x01,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x51, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68,
 	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x55, 0x0a, 0x17, 0x53, 0x65,
-	0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x24, 0x0a, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x22, 0x4b, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x58,
-	0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
-	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x73, 0x0a, 0x21, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x46,
+	0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22,
+	0x55, 0x0a, 0x22, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x75, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x31, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x22, 0xe4, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6,
-	0x01, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x6f, 0x67,
-	0x67, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x6f, 0x67,
-	0x67, 0x65, 0x64, 0x49, 0x6e, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x75,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xf0, 0x04, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x75,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x18, 0x53,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6b, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x18, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x9f, 0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48,
+	0x00, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x39, 0x0a, 0x0b,
+	0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x22, 0x24, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x72, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x8a, 0x01, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x37, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x12, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x83, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x4f, 0x0a, 0x13, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x64, 0x0a, 0x1f, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x71, 0x0a, 0x20, 0x53, 0x65, 0x6e, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x25, 0x53,
 	0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83,
-	0x01, 0x0a, 0x1e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x12, 0x2e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x75, 0x73,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x26, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x75, 0x0a,
+	0x19, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x30, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x22, 0x96, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x47, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x53, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x4d, 0x73, 0x22, 0x74, 0x0a, 0x1b,
+	0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x55, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x18, 0x53, 0x65, 0x6e,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x22, 0x58, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x4c,
+	0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe1, 0x01, 0x0a,
+	0x15, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x10,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x42, 0x0a, 0x14,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x67, 0x0a, 0x16, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x75, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0xb9, 0x02, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x22, 0x31, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x10, 0x01, 0x22, 0xe4, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x94, 0x02, 0x0a,
+	0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x65,
+	0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x67, 0x65,
+	0x64, 0x49, 0x6e, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x75, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x41, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x32, 0xd9, 0x0b, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17,
+	0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x28, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x1e, 0x53,
+	0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2e, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5f, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x75, 0x73,
 	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5c, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x89, 0x01, 0x0a, 0x11, 0x63,
-	0x6c, 0x75, 0x62, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31,
-	0x42, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x52, 0x45, 0x44, 0x2d, 0x43, 0x4c,
-	0x55, 0x42, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x70, 0x75, 0x73,
-	0x68, 0x2f, 0x76, 0x31, 0x3a, 0x70, 0x75, 0x73, 0x68, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58,
-	0x58, 0xaa, 0x02, 0x07, 0x50, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07, 0x50, 0x75,
-	0x73, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x50, 0x75, 0x73, 0x68, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x50, 0x75,
-	0x73, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x62, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x2e, 0x70, 0x75, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5c, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x0e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6b, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x26, 0x2e, 0x70, 0x75, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a,
+	0x1a, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2a, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x75, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x89, 0x01, 0x0a, 0x11, 0x63, 0x6c, 0x75, 0x62, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x52,
+	0x45, 0x44, 0x2d, 0x43, 0x4c, 0x55, 0x42, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x6c, 0x6c, 0x65,
+	0x72, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x3a, 0x70, 0x75, 0x73, 0x68, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x50, 0x75, 0x73, 0x68, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x07, 0x50, 0x75, 0x73, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x50, 0x75, 0x73,
+	0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x08, 0x50, 0x75, 0x73, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1671,7 +3882,7 @@ func file_push_v1_api_proto_rawDescGZIP() []byte {
 }
 
 var file_push_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_push_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_push_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_push_v1_api_proto_goTypes = []any{
 	(Event_Type)(0),                                // 0: push.v1.Event.Type
 	(*ChannelRequest)(nil),                         // 1: push.v1.ChannelRequest
@@ -1679,75 +3890,162 @@ var file_push_v1_api_proto_goTypes = []any{
 	(*ChannelEvent)(nil),                           // 3: push.v1.ChannelEvent
 	(*ConnectAck)(nil),                             // 4: push.v1.ConnectAck
 	(*ChannelEventAck)(nil),                        // 5: push.v1.ChannelEventAck
-	(*TopicSubscriptionRequest)(nil),               // 6: push.v1.TopicSubscriptionRequest
-	(*TopicSubscriptionRequestAck)(nil),            // 7: push.v1.TopicSubscriptionRequestAck
-	(*TopicUnsubscriptionRequest)(nil),             // 8: push.v1.TopicUnsubscriptionRequest
-	(*TopicUnsubscriptionRequestAck)(nil),          // 9: push.v1.TopicUnsubscriptionRequestAck
-	(*GetClientActiveDevicesRequest)(nil),          // 10: push.v1.GetClientActiveDevicesRequest
-	(*GetClientActiveDevicesResponse)(nil),         // 11: push.v1.GetClientActiveDevicesResponse
-	(*SendEventToClientChannelRequest)(nil),        // 12: push.v1.SendEventToClientChannelRequest
-	(*SendEventToClientChannelResponse)(nil),       // 13: push.v1.SendEventToClientChannelResponse
-	(*SendEventToClientDeviceChannelRequest)(nil),  // 14: push.v1.SendEventToClientDeviceChannelRequest
-	(*SendEventToClientDeviceChannelResponse)(nil), // 15: push.v1.SendEventToClientDeviceChannelResponse
-	(*SendEventToTopicRequest)(nil),                // 16: push.v1.SendEventToTopicRequest
-	(*SendEventToTopicResponse)(nil),               // 17: push.v1.SendEventToTopicResponse
-	(*SendEventToTopicsRequest)(nil),               // 18: push.v1.SendEventToTopicsRequest
-	(*SendEventToTopicsResponse)(nil),              // 19: push.v1.SendEventToTopicsResponse
-	(*Event)(nil),                                  // 20: push.v1.Event
-	(*ResponseStatus)(nil),                         // 21: push.v1.ResponseStatus
-	(*Device)(nil),                                 // 22: push.v1.Device
-	nil,                                            // 23: push.v1.ResponseStatus.MessageEntry
-	nil,                                            // 24: push.v1.Device.AttributesEntry
-	(*anypb.Any)(nil),                              // 25: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),                  // 26: google.protobuf.Timestamp
+	(*UpstreamEvent)(nil),                          // 6: push.v1.UpstreamEvent
+	(*TopicSubscriptionRequest)(nil),               // 7: push.v1.TopicSubscriptionRequest
+	(*TopicSubscriptionRequestAck)(nil),            // 8: push.v1.TopicSubscriptionRequestAck
+	(*TopicUnsubscriptionRequest)(nil),             // 9: push.v1.TopicUnsubscriptionRequest
+	(*TopicUnsubscriptionRequestAck)(nil),          // 10: push.v1.TopicUnsubscriptionRequestAck
+	(*EventFilter)(nil),                            // 11: push.v1.EventFilter
+	(*ChannelFilterRequest)(nil),                   // 12: push.v1.ChannelFilterRequest
+	(*ChannelFilterRequestAck)(nil),                // 13: push.v1.ChannelFilterRequestAck
+	(*GetClientActiveDevicesRequest)(nil),          // 14: push.v1.GetClientActiveDevicesRequest
+	(*GetClientActiveDevicesResponse)(nil),         // 15: push.v1.GetClientActiveDevicesResponse
+	(*SubscribeClientToTopicRequest)(nil),          // 16: push.v1.SubscribeClientToTopicRequest
+	(*SubscribeClientToTopicResponse)(nil),         // 17: push.v1.SubscribeClientToTopicResponse
+	(*UnsubscribeClientFromTopicRequest)(nil),      // 18: push.v1.UnsubscribeClientFromTopicRequest
+	(*UnsubscribeClientFromTopicResponse)(nil),     // 19: push.v1.UnsubscribeClientFromTopicResponse
+	(*DisconnectClientRequest)(nil),                // 20: push.v1.DisconnectClientRequest
+	(*DisconnectClientResponse)(nil),               // 21: push.v1.DisconnectClientResponse
+	(*ControlEvent)(nil),                           // 22: push.v1.ControlEvent
+	(*TopicControl)(nil),                           // 23: push.v1.TopicControl
+	(*DisconnectControl)(nil),                      // 24: push.v1.DisconnectControl
+	(*GetClientsOnlineStatusRequest)(nil),          // 25: push.v1.GetClientsOnlineStatusRequest
+	(*GetClientsOnlineStatusResponse)(nil),         // 26: push.v1.GetClientsOnlineStatusResponse
+	(*ClientOnlineStatus)(nil),                     // 27: push.v1.ClientOnlineStatus
+	(*GetTopicPresenceRequest)(nil),                // 28: push.v1.GetTopicPresenceRequest
+	(*GetTopicPresenceResponse)(nil),               // 29: push.v1.GetTopicPresenceResponse
+	(*ListNodesRequest)(nil),                       // 30: push.v1.ListNodesRequest
+	(*ListNodesResponse)(nil),                      // 31: push.v1.ListNodesResponse
+	(*Node)(nil),                                   // 32: push.v1.Node
+	(*TopicPresenceMember)(nil),                    // 33: push.v1.TopicPresenceMember
+	(*SendEventToClientChannelRequest)(nil),        // 34: push.v1.SendEventToClientChannelRequest
+	(*SendEventToClientChannelResponse)(nil),       // 35: push.v1.SendEventToClientChannelResponse
+	(*SendEventToClientDeviceChannelRequest)(nil),  // 36: push.v1.SendEventToClientDeviceChannelRequest
+	(*SendEventToClientDeviceChannelResponse)(nil), // 37: push.v1.SendEventToClientDeviceChannelResponse
+	(*SendEventToClientsRequest)(nil),              // 38: push.v1.SendEventToClientsRequest
+	(*Recipient)(nil),                              // 39: push.v1.Recipient
+	(*RecipientStatus)(nil),                        // 40: push.v1.RecipientStatus
+	(*SendEventToClientsResponse)(nil),             // 41: push.v1.SendEventToClientsResponse
+	(*SendRequestToClientRequest)(nil),             // 42: push.v1.SendRequestToClientRequest
+	(*SendRequestToClientResponse)(nil),            // 43: push.v1.SendRequestToClientResponse
+	(*SendEventToTopicRequest)(nil),                // 44: push.v1.SendEventToTopicRequest
+	(*SendEventToTopicResponse)(nil),               // 45: push.v1.SendEventToTopicResponse
+	(*SendEventToTopicsRequest)(nil),               // 46: push.v1.SendEventToTopicsRequest
+	(*SendEventToTopicsResponse)(nil),              // 47: push.v1.SendEventToTopicsResponse
+	(*BroadcastEventRequest)(nil),                  // 48: push.v1.BroadcastEventRequest
+	(*BroadcastEventResponse)(nil),                 // 49: push.v1.BroadcastEventResponse
+	(*Event)(nil),                                  // 50: push.v1.Event
+	(*ResponseStatus)(nil),                         // 51: push.v1.ResponseStatus
+	(*Device)(nil),                                 // 52: push.v1.Device
+	nil,                                            // 53: push.v1.BroadcastEventRequest.AttributeFilterEntry
+	nil,                                            // 54: push.v1.ResponseStatus.MessageEntry
+	nil,                                            // 55: push.v1.Device.AttributesEntry
+	(*timestamppb.Timestamp)(nil),                  // 56: google.protobuf.Timestamp
+	(*anypb.Any)(nil),                              // 57: google.protobuf.Any
 }
 var file_push_v1_api_proto_depIdxs = []int32{
 	3,  // 0: push.v1.ChannelRequest.channel_event:type_name -> push.v1.ChannelEvent
 	5,  // 1: push.v1.ChannelRequest.channel_event_ack:type_name -> push.v1.ChannelEventAck
-	6,  // 2: push.v1.ChannelRequest.topic_subscription_request:type_name -> push.v1.TopicSubscriptionRequest
-	8,  // 3: push.v1.ChannelRequest.topic_unsubscription_request:type_name -> push.v1.TopicUnsubscriptionRequest
-	4,  // 4: push.v1.ChannelResponse.connect_ack:type_name -> push.v1.ConnectAck
-	3,  // 5: push.v1.ChannelResponse.channel_event:type_name -> push.v1.ChannelEvent
-	5,  // 6: push.v1.ChannelResponse.channel_event_ack:type_name -> push.v1.ChannelEventAck
-	7,  // 7: push.v1.ChannelResponse.topic_subscription_request_ack:type_name -> push.v1.TopicSubscriptionRequestAck
-	9,  // 8: push.v1.ChannelResponse.topic_unsubscription_request_ack:type_name -> push.v1.TopicUnsubscriptionRequestAck
-	20, // 9: push.v1.ChannelEvent.event:type_name -> push.v1.Event
-	21, // 10: push.v1.ConnectAck.status:type_name -> push.v1.ResponseStatus
-	21, // 11: push.v1.ChannelEventAck.status:type_name -> push.v1.ResponseStatus
-	21, // 12: push.v1.TopicSubscriptionRequestAck.status:type_name -> push.v1.ResponseStatus
-	21, // 13: push.v1.TopicUnsubscriptionRequestAck.status:type_name -> push.v1.ResponseStatus
-	21, // 14: push.v1.GetClientActiveDevicesResponse.status:type_name -> push.v1.ResponseStatus
-	22, // 15: push.v1.GetClientActiveDevicesResponse.devices:type_name -> push.v1.Device
-	20, // 16: push.v1.SendEventToClientChannelRequest.event:type_name -> push.v1.Event
-	21, // 17: push.v1.SendEventToClientChannelResponse.status:type_name -> push.v1.ResponseStatus
-	20, // 18: push.v1.SendEventToClientDeviceChannelRequest.event:type_name -> push.v1.Event
-	21, // 19: push.v1.SendEventToClientDeviceChannelResponse.status:type_name -> push.v1.ResponseStatus
-	20, // 20: push.v1.SendEventToTopicRequest.event:type_name -> push.v1.Event
-	21, // 21: push.v1.SendEventToTopicResponse.status:type_name -> push.v1.ResponseStatus
-	16, // 22: push.v1.SendEventToTopicsRequest.requests:type_name -> push.v1.SendEventToTopicRequest
-	21, // 23: push.v1.SendEventToTopicsResponse.status:type_name -> push.v1.ResponseStatus
-	0,  // 24: push.v1.Event.format_type:type_name -> push.v1.Event.Type
-	25, // 25: push.v1.Event.data:type_name -> google.protobuf.Any
-	23, // 26: push.v1.ResponseStatus.message:type_name -> push.v1.ResponseStatus.MessageEntry
-	26, // 27: push.v1.Device.logged_in_at:type_name -> google.protobuf.Timestamp
-	24, // 28: push.v1.Device.attributes:type_name -> push.v1.Device.AttributesEntry
-	1,  // 29: push.v1.PushService.Channel:input_type -> push.v1.ChannelRequest
-	12, // 30: push.v1.PushService.SendEventToClientChannel:input_type -> push.v1.SendEventToClientChannelRequest
-	14, // 31: push.v1.PushService.SendEventToClientDeviceChannel:input_type -> push.v1.SendEventToClientDeviceChannelRequest
-	16, // 32: push.v1.PushService.SendEventToTopic:input_type -> push.v1.SendEventToTopicRequest
-	18, // 33: push.v1.PushService.SendEventToTopics:input_type -> push.v1.SendEventToTopicsRequest
-	10, // 34: push.v1.PushService.GetClientActiveDevices:input_type -> push.v1.GetClientActiveDevicesRequest
-	2,  // 35: push.v1.PushService.Channel:output_type -> push.v1.ChannelResponse
-	13, // 36: push.v1.PushService.SendEventToClientChannel:output_type -> push.v1.SendEventToClientChannelResponse
-	15, // 37: push.v1.PushService.SendEventToClientDeviceChannel:output_type -> push.v1.SendEventToClientDeviceChannelResponse
-	17, // 38: push.v1.PushService.SendEventToTopic:output_type -> push.v1.SendEventToTopicResponse
-	19, // 39: push.v1.PushService.SendEventToTopics:output_type -> push.v1.SendEventToTopicsResponse
-	11, // 40: push.v1.PushService.GetClientActiveDevices:output_type -> push.v1.GetClientActiveDevicesResponse
-	35, // [35:41] is the sub-list for method output_type
-	29, // [29:35] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	7,  // 2: push.v1.ChannelRequest.topic_subscription_request:type_name -> push.v1.TopicSubscriptionRequest
+	9,  // 3: push.v1.ChannelRequest.topic_unsubscription_request:type_name -> push.v1.TopicUnsubscriptionRequest
+	12, // 4: push.v1.ChannelRequest.channel_filter_request:type_name -> push.v1.ChannelFilterRequest
+	4,  // 5: push.v1.ChannelResponse.connect_ack:type_name -> push.v1.ConnectAck
+	3,  // 6: push.v1.ChannelResponse.channel_event:type_name -> push.v1.ChannelEvent
+	5,  // 7: push.v1.ChannelResponse.channel_event_ack:type_name -> push.v1.ChannelEventAck
+	8,  // 8: push.v1.ChannelResponse.topic_subscription_request_ack:type_name -> push.v1.TopicSubscriptionRequestAck
+	10, // 9: push.v1.ChannelResponse.topic_unsubscription_request_ack:type_name -> push.v1.TopicUnsubscriptionRequestAck
+	13, // 10: push.v1.ChannelResponse.channel_filter_request_ack:type_name -> push.v1.ChannelFilterRequestAck
+	50, // 11: push.v1.ChannelEvent.event:type_name -> push.v1.Event
+	51, // 12: push.v1.ConnectAck.status:type_name -> push.v1.ResponseStatus
+	8,  // 13: push.v1.ConnectAck.topic_acks:type_name -> push.v1.TopicSubscriptionRequestAck
+	51, // 14: push.v1.ChannelEventAck.status:type_name -> push.v1.ResponseStatus
+	3,  // 15: push.v1.UpstreamEvent.channel_event:type_name -> push.v1.ChannelEvent
+	56, // 16: push.v1.UpstreamEvent.received_at:type_name -> google.protobuf.Timestamp
+	11, // 17: push.v1.TopicSubscriptionRequest.filter:type_name -> push.v1.EventFilter
+	51, // 18: push.v1.TopicSubscriptionRequestAck.status:type_name -> push.v1.ResponseStatus
+	51, // 19: push.v1.TopicUnsubscriptionRequestAck.status:type_name -> push.v1.ResponseStatus
+	11, // 20: push.v1.ChannelFilterRequest.filter:type_name -> push.v1.EventFilter
+	51, // 21: push.v1.ChannelFilterRequestAck.status:type_name -> push.v1.ResponseStatus
+	51, // 22: push.v1.GetClientActiveDevicesResponse.status:type_name -> push.v1.ResponseStatus
+	52, // 23: push.v1.GetClientActiveDevicesResponse.devices:type_name -> push.v1.Device
+	51, // 24: push.v1.SubscribeClientToTopicResponse.status:type_name -> push.v1.ResponseStatus
+	51, // 25: push.v1.UnsubscribeClientFromTopicResponse.status:type_name -> push.v1.ResponseStatus
+	51, // 26: push.v1.DisconnectClientResponse.status:type_name -> push.v1.ResponseStatus
+	56, // 27: push.v1.ControlEvent.issued_at:type_name -> google.protobuf.Timestamp
+	24, // 28: push.v1.ControlEvent.disconnect:type_name -> push.v1.DisconnectControl
+	23, // 29: push.v1.ControlEvent.subscribe:type_name -> push.v1.TopicControl
+	23, // 30: push.v1.ControlEvent.unsubscribe:type_name -> push.v1.TopicControl
+	50, // 31: push.v1.DisconnectControl.event:type_name -> push.v1.Event
+	51, // 32: push.v1.GetClientsOnlineStatusResponse.status:type_name -> push.v1.ResponseStatus
+	27, // 33: push.v1.GetClientsOnlineStatusResponse.statuses:type_name -> push.v1.ClientOnlineStatus
+	51, // 34: push.v1.GetTopicPresenceResponse.status:type_name -> push.v1.ResponseStatus
+	33, // 35: push.v1.GetTopicPresenceResponse.members:type_name -> push.v1.TopicPresenceMember
+	51, // 36: push.v1.ListNodesResponse.status:type_name -> push.v1.ResponseStatus
+	32, // 37: push.v1.ListNodesResponse.nodes:type_name -> push.v1.Node
+	56, // 38: push.v1.Node.started_at:type_name -> google.protobuf.Timestamp
+	56, // 39: push.v1.Node.last_seen_at:type_name -> google.protobuf.Timestamp
+	50, // 40: push.v1.SendEventToClientChannelRequest.event:type_name -> push.v1.Event
+	51, // 41: push.v1.SendEventToClientChannelResponse.status:type_name -> push.v1.ResponseStatus
+	50, // 42: push.v1.SendEventToClientDeviceChannelRequest.event:type_name -> push.v1.Event
+	51, // 43: push.v1.SendEventToClientDeviceChannelResponse.status:type_name -> push.v1.ResponseStatus
+	39, // 44: push.v1.SendEventToClientsRequest.recipients:type_name -> push.v1.Recipient
+	50, // 45: push.v1.SendEventToClientsRequest.event:type_name -> push.v1.Event
+	39, // 46: push.v1.RecipientStatus.recipient:type_name -> push.v1.Recipient
+	51, // 47: push.v1.RecipientStatus.status:type_name -> push.v1.ResponseStatus
+	51, // 48: push.v1.SendEventToClientsResponse.status:type_name -> push.v1.ResponseStatus
+	40, // 49: push.v1.SendEventToClientsResponse.recipient_statuses:type_name -> push.v1.RecipientStatus
+	50, // 50: push.v1.SendRequestToClientRequest.event:type_name -> push.v1.Event
+	51, // 51: push.v1.SendRequestToClientResponse.status:type_name -> push.v1.ResponseStatus
+	50, // 52: push.v1.SendRequestToClientResponse.reply:type_name -> push.v1.Event
+	50, // 53: push.v1.SendEventToTopicRequest.event:type_name -> push.v1.Event
+	51, // 54: push.v1.SendEventToTopicResponse.status:type_name -> push.v1.ResponseStatus
+	44, // 55: push.v1.SendEventToTopicsRequest.requests:type_name -> push.v1.SendEventToTopicRequest
+	51, // 56: push.v1.SendEventToTopicsResponse.status:type_name -> push.v1.ResponseStatus
+	50, // 57: push.v1.BroadcastEventRequest.event:type_name -> push.v1.Event
+	53, // 58: push.v1.BroadcastEventRequest.attribute_filter:type_name -> push.v1.BroadcastEventRequest.AttributeFilterEntry
+	51, // 59: push.v1.BroadcastEventResponse.status:type_name -> push.v1.ResponseStatus
+	0,  // 60: push.v1.Event.format_type:type_name -> push.v1.Event.Type
+	57, // 61: push.v1.Event.data:type_name -> google.protobuf.Any
+	56, // 62: push.v1.Event.expires_at:type_name -> google.protobuf.Timestamp
+	54, // 63: push.v1.ResponseStatus.message:type_name -> push.v1.ResponseStatus.MessageEntry
+	56, // 64: push.v1.Device.logged_in_at:type_name -> google.protobuf.Timestamp
+	55, // 65: push.v1.Device.attributes:type_name -> push.v1.Device.AttributesEntry
+	56, // 66: push.v1.Device.last_seen_at:type_name -> google.protobuf.Timestamp
+	1,  // 67: push.v1.PushService.Channel:input_type -> push.v1.ChannelRequest
+	34, // 68: push.v1.PushService.SendEventToClientChannel:input_type -> push.v1.SendEventToClientChannelRequest
+	36, // 69: push.v1.PushService.SendEventToClientDeviceChannel:input_type -> push.v1.SendEventToClientDeviceChannelRequest
+	38, // 70: push.v1.PushService.SendEventToClients:input_type -> push.v1.SendEventToClientsRequest
+	42, // 71: push.v1.PushService.SendRequestToClient:input_type -> push.v1.SendRequestToClientRequest
+	44, // 72: push.v1.PushService.SendEventToTopic:input_type -> push.v1.SendEventToTopicRequest
+	46, // 73: push.v1.PushService.SendEventToTopics:input_type -> push.v1.SendEventToTopicsRequest
+	48, // 74: push.v1.PushService.BroadcastEvent:input_type -> push.v1.BroadcastEventRequest
+	14, // 75: push.v1.PushService.GetClientActiveDevices:input_type -> push.v1.GetClientActiveDevicesRequest
+	16, // 76: push.v1.PushService.SubscribeClientToTopic:input_type -> push.v1.SubscribeClientToTopicRequest
+	18, // 77: push.v1.PushService.UnsubscribeClientFromTopic:input_type -> push.v1.UnsubscribeClientFromTopicRequest
+	20, // 78: push.v1.PushService.DisconnectClient:input_type -> push.v1.DisconnectClientRequest
+	25, // 79: push.v1.PushService.GetClientsOnlineStatus:input_type -> push.v1.GetClientsOnlineStatusRequest
+	28, // 80: push.v1.PushService.GetTopicPresence:input_type -> push.v1.GetTopicPresenceRequest
+	30, // 81: push.v1.PushService.ListNodes:input_type -> push.v1.ListNodesRequest
+	2,  // 82: push.v1.PushService.Channel:output_type -> push.v1.ChannelResponse
+	35, // 83: push.v1.PushService.SendEventToClientChannel:output_type -> push.v1.SendEventToClientChannelResponse
+	37, // 84: push.v1.PushService.SendEventToClientDeviceChannel:output_type -> push.v1.SendEventToClientDeviceChannelResponse
+	41, // 85: push.v1.PushService.SendEventToClients:output_type -> push.v1.SendEventToClientsResponse
+	43, // 86: push.v1.PushService.SendRequestToClient:output_type -> push.v1.SendRequestToClientResponse
+	45, // 87: push.v1.PushService.SendEventToTopic:output_type -> push.v1.SendEventToTopicResponse
+	47, // 88: push.v1.PushService.SendEventToTopics:output_type -> push.v1.SendEventToTopicsResponse
+	49, // 89: push.v1.PushService.BroadcastEvent:output_type -> push.v1.BroadcastEventResponse
+	15, // 90: push.v1.PushService.GetClientActiveDevices:output_type -> push.v1.GetClientActiveDevicesResponse
+	17, // 91: push.v1.PushService.SubscribeClientToTopic:output_type -> push.v1.SubscribeClientToTopicResponse
+	19, // 92: push.v1.PushService.UnsubscribeClientFromTopic:output_type -> push.v1.UnsubscribeClientFromTopicResponse
+	21, // 93: push.v1.PushService.DisconnectClient:output_type -> push.v1.DisconnectClientResponse
+	26, // 94: push.v1.PushService.GetClientsOnlineStatus:output_type -> push.v1.GetClientsOnlineStatusResponse
+	29, // 95: push.v1.PushService.GetTopicPresence:output_type -> push.v1.GetTopicPresenceResponse
+	31, // 96: push.v1.PushService.ListNodes:output_type -> push.v1.ListNodesResponse
+	82, // [82:97] is the sub-list for method output_type
+	67, // [67:82] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_push_v1_api_proto_init() }
@@ -1760,6 +4058,7 @@ func file_push_v1_api_proto_init() {
 		(*ChannelRequest_ChannelEventAck)(nil),
 		(*ChannelRequest_TopicSubscriptionRequest)(nil),
 		(*ChannelRequest_TopicUnsubscriptionRequest)(nil),
+		(*ChannelRequest_ChannelFilterRequest)(nil),
 	}
 	file_push_v1_api_proto_msgTypes[1].OneofWrappers = []any{
 		(*ChannelResponse_ConnectAck)(nil),
@@ -1767,6 +4066,12 @@ func file_push_v1_api_proto_init() {
 		(*ChannelResponse_ChannelEventAck)(nil),
 		(*ChannelResponse_TopicSubscriptionRequestAck)(nil),
 		(*ChannelResponse_TopicUnsubscriptionRequestAck)(nil),
+		(*ChannelResponse_ChannelFilterRequestAck)(nil),
+	}
+	file_push_v1_api_proto_msgTypes[21].OneofWrappers = []any{
+		(*ControlEvent_Disconnect)(nil),
+		(*ControlEvent_Subscribe)(nil),
+		(*ControlEvent_Unsubscribe)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1774,7 +4079,7 @@ func file_push_v1_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_push_v1_api_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   24,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
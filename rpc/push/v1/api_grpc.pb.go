@@ -22,9 +22,18 @@ const (
 	PushService_Channel_FullMethodName                        = "/push.v1.PushService/Channel"
 	PushService_SendEventToClientChannel_FullMethodName       = "/push.v1.PushService/SendEventToClientChannel"
 	PushService_SendEventToClientDeviceChannel_FullMethodName = "/push.v1.PushService/SendEventToClientDeviceChannel"
+	PushService_SendEventToClients_FullMethodName             = "/push.v1.PushService/SendEventToClients"
+	PushService_SendRequestToClient_FullMethodName            = "/push.v1.PushService/SendRequestToClient"
 	PushService_SendEventToTopic_FullMethodName               = "/push.v1.PushService/SendEventToTopic"
 	PushService_SendEventToTopics_FullMethodName              = "/push.v1.PushService/SendEventToTopics"
+	PushService_BroadcastEvent_FullMethodName                 = "/push.v1.PushService/BroadcastEvent"
 	PushService_GetClientActiveDevices_FullMethodName         = "/push.v1.PushService/GetClientActiveDevices"
+	PushService_SubscribeClientToTopic_FullMethodName         = "/push.v1.PushService/SubscribeClientToTopic"
+	PushService_UnsubscribeClientFromTopic_FullMethodName     = "/push.v1.PushService/UnsubscribeClientFromTopic"
+	PushService_DisconnectClient_FullMethodName               = "/push.v1.PushService/DisconnectClient"
+	PushService_GetClientsOnlineStatus_FullMethodName         = "/push.v1.PushService/GetClientsOnlineStatus"
+	PushService_GetTopicPresence_FullMethodName               = "/push.v1.PushService/GetTopicPresence"
+	PushService_ListNodes_FullMethodName                      = "/push.v1.PushService/ListNodes"
 )
 
 // PushServiceClient is the client API for PushService service.
@@ -39,12 +48,30 @@ type PushServiceClient interface {
 	SendEventToClientChannel(ctx context.Context, in *SendEventToClientChannelRequest, opts ...grpc.CallOption) (*SendEventToClientChannelResponse, error)
 	// SendEventToClientDeviceChannel is called to send event to a client device
 	SendEventToClientDeviceChannel(ctx context.Context, in *SendEventToClientDeviceChannelRequest, opts ...grpc.CallOption) (*SendEventToClientDeviceChannelResponse, error)
+	// SendEventToClients is called to send an event to multiple clients
+	SendEventToClients(ctx context.Context, in *SendEventToClientsRequest, opts ...grpc.CallOption) (*SendEventToClientsResponse, error)
+	// SendRequestToClient is called to send an event to a client and wait for its reply
+	SendRequestToClient(ctx context.Context, in *SendRequestToClientRequest, opts ...grpc.CallOption) (*SendRequestToClientResponse, error)
 	// SendEventToTopic is called to send event to a topic
 	SendEventToTopic(ctx context.Context, in *SendEventToTopicRequest, opts ...grpc.CallOption) (*SendEventToTopicResponse, error)
 	// SendEventToTopics is called to send event to multiple topics
 	SendEventToTopics(ctx context.Context, in *SendEventToTopicsRequest, opts ...grpc.CallOption) (*SendEventToTopicsResponse, error)
+	// BroadcastEvent is called to send an event to every connected client
+	BroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error)
 	// GetClientActiveDevices is called to get active devices of a client
 	GetClientActiveDevices(ctx context.Context, in *GetClientActiveDevicesRequest, opts ...grpc.CallOption) (*GetClientActiveDevicesResponse, error)
+	// SubscribeClientToTopic is called to subscribe the channels of a client, or a device of the client, to a topic
+	SubscribeClientToTopic(ctx context.Context, in *SubscribeClientToTopicRequest, opts ...grpc.CallOption) (*SubscribeClientToTopicResponse, error)
+	// UnsubscribeClientFromTopic is called to unsubscribe the channels of a client, or a device of the client, from a topic
+	UnsubscribeClientFromTopic(ctx context.Context, in *UnsubscribeClientFromTopicRequest, opts ...grpc.CallOption) (*UnsubscribeClientFromTopicResponse, error)
+	// DisconnectClient is called to close the channels of a client, or a device of the client
+	DisconnectClient(ctx context.Context, in *DisconnectClientRequest, opts ...grpc.CallOption) (*DisconnectClientResponse, error)
+	// GetClientsOnlineStatus is called to get the online status of multiple clients
+	GetClientsOnlineStatus(ctx context.Context, in *GetClientsOnlineStatusRequest, opts ...grpc.CallOption) (*GetClientsOnlineStatusResponse, error)
+	// GetTopicPresence is called to get the clients subscribed to a topic
+	GetTopicPresence(ctx context.Context, in *GetTopicPresenceRequest, opts ...grpc.CallOption) (*GetTopicPresenceResponse, error)
+	// ListNodes is called to get the live nodes of the cluster
+	ListNodes(ctx context.Context, in *ListNodesRequest, opts ...grpc.CallOption) (*ListNodesResponse, error)
 }
 
 type pushServiceClient struct {
@@ -88,6 +115,26 @@ func (c *pushServiceClient) SendEventToClientDeviceChannel(ctx context.Context,
 	return out, nil
 }
 
+func (c *pushServiceClient) SendEventToClients(ctx context.Context, in *SendEventToClientsRequest, opts ...grpc.CallOption) (*SendEventToClientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendEventToClientsResponse)
+	err := c.cc.Invoke(ctx, PushService_SendEventToClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) SendRequestToClient(ctx context.Context, in *SendRequestToClientRequest, opts ...grpc.CallOption) (*SendRequestToClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendRequestToClientResponse)
+	err := c.cc.Invoke(ctx, PushService_SendRequestToClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pushServiceClient) SendEventToTopic(ctx context.Context, in *SendEventToTopicRequest, opts ...grpc.CallOption) (*SendEventToTopicResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendEventToTopicResponse)
@@ -108,6 +155,16 @@ func (c *pushServiceClient) SendEventToTopics(ctx context.Context, in *SendEvent
 	return out, nil
 }
 
+func (c *pushServiceClient) BroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastEventResponse)
+	err := c.cc.Invoke(ctx, PushService_BroadcastEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pushServiceClient) GetClientActiveDevices(ctx context.Context, in *GetClientActiveDevicesRequest, opts ...grpc.CallOption) (*GetClientActiveDevicesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetClientActiveDevicesResponse)
@@ -118,6 +175,66 @@ func (c *pushServiceClient) GetClientActiveDevices(ctx context.Context, in *GetC
 	return out, nil
 }
 
+func (c *pushServiceClient) SubscribeClientToTopic(ctx context.Context, in *SubscribeClientToTopicRequest, opts ...grpc.CallOption) (*SubscribeClientToTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeClientToTopicResponse)
+	err := c.cc.Invoke(ctx, PushService_SubscribeClientToTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) UnsubscribeClientFromTopic(ctx context.Context, in *UnsubscribeClientFromTopicRequest, opts ...grpc.CallOption) (*UnsubscribeClientFromTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeClientFromTopicResponse)
+	err := c.cc.Invoke(ctx, PushService_UnsubscribeClientFromTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) DisconnectClient(ctx context.Context, in *DisconnectClientRequest, opts ...grpc.CallOption) (*DisconnectClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisconnectClientResponse)
+	err := c.cc.Invoke(ctx, PushService_DisconnectClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) GetClientsOnlineStatus(ctx context.Context, in *GetClientsOnlineStatusRequest, opts ...grpc.CallOption) (*GetClientsOnlineStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClientsOnlineStatusResponse)
+	err := c.cc.Invoke(ctx, PushService_GetClientsOnlineStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) GetTopicPresence(ctx context.Context, in *GetTopicPresenceRequest, opts ...grpc.CallOption) (*GetTopicPresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopicPresenceResponse)
+	err := c.cc.Invoke(ctx, PushService_GetTopicPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushServiceClient) ListNodes(ctx context.Context, in *ListNodesRequest, opts ...grpc.CallOption) (*ListNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNodesResponse)
+	err := c.cc.Invoke(ctx, PushService_ListNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PushServiceServer is the server API for PushService service.
 // All implementations should embed UnimplementedPushServiceServer
 // for forward compatibility.
@@ -130,12 +247,30 @@ type PushServiceServer interface {
 	SendEventToClientChannel(context.Context, *SendEventToClientChannelRequest) (*SendEventToClientChannelResponse, error)
 	// SendEventToClientDeviceChannel is called to send event to a client device
 	SendEventToClientDeviceChannel(context.Context, *SendEventToClientDeviceChannelRequest) (*SendEventToClientDeviceChannelResponse, error)
+	// SendEventToClients is called to send an event to multiple clients
+	SendEventToClients(context.Context, *SendEventToClientsRequest) (*SendEventToClientsResponse, error)
+	// SendRequestToClient is called to send an event to a client and wait for its reply
+	SendRequestToClient(context.Context, *SendRequestToClientRequest) (*SendRequestToClientResponse, error)
 	// SendEventToTopic is called to send event to a topic
 	SendEventToTopic(context.Context, *SendEventToTopicRequest) (*SendEventToTopicResponse, error)
 	// SendEventToTopics is called to send event to multiple topics
 	SendEventToTopics(context.Context, *SendEventToTopicsRequest) (*SendEventToTopicsResponse, error)
+	// BroadcastEvent is called to send an event to every connected client
+	BroadcastEvent(context.Context, *BroadcastEventRequest) (*BroadcastEventResponse, error)
 	// GetClientActiveDevices is called to get active devices of a client
 	GetClientActiveDevices(context.Context, *GetClientActiveDevicesRequest) (*GetClientActiveDevicesResponse, error)
+	// SubscribeClientToTopic is called to subscribe the channels of a client, or a device of the client, to a topic
+	SubscribeClientToTopic(context.Context, *SubscribeClientToTopicRequest) (*SubscribeClientToTopicResponse, error)
+	// UnsubscribeClientFromTopic is called to unsubscribe the channels of a client, or a device of the client, from a topic
+	UnsubscribeClientFromTopic(context.Context, *UnsubscribeClientFromTopicRequest) (*UnsubscribeClientFromTopicResponse, error)
+	// DisconnectClient is called to close the channels of a client, or a device of the client
+	DisconnectClient(context.Context, *DisconnectClientRequest) (*DisconnectClientResponse, error)
+	// GetClientsOnlineStatus is called to get the online status of multiple clients
+	GetClientsOnlineStatus(context.Context, *GetClientsOnlineStatusRequest) (*GetClientsOnlineStatusResponse, error)
+	// GetTopicPresence is called to get the clients subscribed to a topic
+	GetTopicPresence(context.Context, *GetTopicPresenceRequest) (*GetTopicPresenceResponse, error)
+	// ListNodes is called to get the live nodes of the cluster
+	ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error)
 }
 
 // UnimplementedPushServiceServer should be embedded to have
@@ -154,15 +289,42 @@ func (UnimplementedPushServiceServer) SendEventToClientChannel(context.Context,
 func (UnimplementedPushServiceServer) SendEventToClientDeviceChannel(context.Context, *SendEventToClientDeviceChannelRequest) (*SendEventToClientDeviceChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEventToClientDeviceChannel not implemented")
 }
+func (UnimplementedPushServiceServer) SendEventToClients(context.Context, *SendEventToClientsRequest) (*SendEventToClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEventToClients not implemented")
+}
+func (UnimplementedPushServiceServer) SendRequestToClient(context.Context, *SendRequestToClientRequest) (*SendRequestToClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRequestToClient not implemented")
+}
 func (UnimplementedPushServiceServer) SendEventToTopic(context.Context, *SendEventToTopicRequest) (*SendEventToTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEventToTopic not implemented")
 }
 func (UnimplementedPushServiceServer) SendEventToTopics(context.Context, *SendEventToTopicsRequest) (*SendEventToTopicsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEventToTopics not implemented")
 }
+func (UnimplementedPushServiceServer) BroadcastEvent(context.Context, *BroadcastEventRequest) (*BroadcastEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastEvent not implemented")
+}
 func (UnimplementedPushServiceServer) GetClientActiveDevices(context.Context, *GetClientActiveDevicesRequest) (*GetClientActiveDevicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClientActiveDevices not implemented")
 }
+func (UnimplementedPushServiceServer) SubscribeClientToTopic(context.Context, *SubscribeClientToTopicRequest) (*SubscribeClientToTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeClientToTopic not implemented")
+}
+func (UnimplementedPushServiceServer) UnsubscribeClientFromTopic(context.Context, *UnsubscribeClientFromTopicRequest) (*UnsubscribeClientFromTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeClientFromTopic not implemented")
+}
+func (UnimplementedPushServiceServer) DisconnectClient(context.Context, *DisconnectClientRequest) (*DisconnectClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectClient not implemented")
+}
+func (UnimplementedPushServiceServer) GetClientsOnlineStatus(context.Context, *GetClientsOnlineStatusRequest) (*GetClientsOnlineStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientsOnlineStatus not implemented")
+}
+func (UnimplementedPushServiceServer) GetTopicPresence(context.Context, *GetTopicPresenceRequest) (*GetTopicPresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicPresence not implemented")
+}
+func (UnimplementedPushServiceServer) ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodes not implemented")
+}
 func (UnimplementedPushServiceServer) testEmbeddedByValue() {}
 
 // UnsafePushServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -226,6 +388,42 @@ func _PushService_SendEventToClientDeviceChannel_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushService_SendEventToClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEventToClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).SendEventToClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_SendEventToClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).SendEventToClients(ctx, req.(*SendEventToClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_SendRequestToClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequestToClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).SendRequestToClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_SendRequestToClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).SendRequestToClient(ctx, req.(*SendRequestToClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PushService_SendEventToTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendEventToTopicRequest)
 	if err := dec(in); err != nil {
@@ -262,6 +460,24 @@ func _PushService_SendEventToTopics_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushService_BroadcastEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).BroadcastEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_BroadcastEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).BroadcastEvent(ctx, req.(*BroadcastEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PushService_GetClientActiveDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetClientActiveDevicesRequest)
 	if err := dec(in); err != nil {
@@ -280,6 +496,114 @@ func _PushService_GetClientActiveDevices_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushService_SubscribeClientToTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeClientToTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).SubscribeClientToTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_SubscribeClientToTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).SubscribeClientToTopic(ctx, req.(*SubscribeClientToTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_UnsubscribeClientFromTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeClientFromTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).UnsubscribeClientFromTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_UnsubscribeClientFromTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).UnsubscribeClientFromTopic(ctx, req.(*UnsubscribeClientFromTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_DisconnectClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).DisconnectClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_DisconnectClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).DisconnectClient(ctx, req.(*DisconnectClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_GetClientsOnlineStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientsOnlineStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).GetClientsOnlineStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_GetClientsOnlineStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).GetClientsOnlineStatus(ctx, req.(*GetClientsOnlineStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_GetTopicPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).GetTopicPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_GetTopicPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).GetTopicPresence(ctx, req.(*GetTopicPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushService_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushServiceServer).ListNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushService_ListNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushServiceServer).ListNodes(ctx, req.(*ListNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PushService_ServiceDesc is the grpc.ServiceDesc for PushService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -295,6 +619,14 @@ var PushService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEventToClientDeviceChannel",
 			Handler:    _PushService_SendEventToClientDeviceChannel_Handler,
 		},
+		{
+			MethodName: "SendEventToClients",
+			Handler:    _PushService_SendEventToClients_Handler,
+		},
+		{
+			MethodName: "SendRequestToClient",
+			Handler:    _PushService_SendRequestToClient_Handler,
+		},
 		{
 			MethodName: "SendEventToTopic",
 			Handler:    _PushService_SendEventToTopic_Handler,
@@ -303,10 +635,38 @@ var PushService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEventToTopics",
 			Handler:    _PushService_SendEventToTopics_Handler,
 		},
+		{
+			MethodName: "BroadcastEvent",
+			Handler:    _PushService_BroadcastEvent_Handler,
+		},
 		{
 			MethodName: "GetClientActiveDevices",
 			Handler:    _PushService_GetClientActiveDevices_Handler,
 		},
+		{
+			MethodName: "SubscribeClientToTopic",
+			Handler:    _PushService_SubscribeClientToTopic_Handler,
+		},
+		{
+			MethodName: "UnsubscribeClientFromTopic",
+			Handler:    _PushService_UnsubscribeClientFromTopic_Handler,
+		},
+		{
+			MethodName: "DisconnectClient",
+			Handler:    _PushService_DisconnectClient_Handler,
+		},
+		{
+			MethodName: "GetClientsOnlineStatus",
+			Handler:    _PushService_GetClientsOnlineStatus_Handler,
+		},
+		{
+			MethodName: "GetTopicPresence",
+			Handler:    _PushService_GetTopicPresence_Handler,
+		},
+		{
+			MethodName: "ListNodes",
+			Handler:    _PushService_ListNodes_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{